@@ -37,10 +37,33 @@ func initConfig() {
 	viper.BindEnv("server.listen")
 	viper.BindEnv("network.url")
 	viper.BindEnv("network.timeout")
+	viper.BindEnv("network.auth_token")
+	viper.BindEnv("network.detect_network_id")
+	viper.BindEnv("network.check_amendments")
+	viper.BindEnv("network.audit_log")
+	viper.BindEnv("network.account_cache_ttl")
+	viper.BindEnv("network.submit_mode")
+	viper.BindEnv("network.ledger_offset")
+	viper.BindEnv("network.confirmation_depth")
+	viper.BindEnv("network.max_batch_size")
+	viper.BindEnv("network.wallet_cache_size")
+	viper.BindEnv("network.correlation_memo")
+	viper.BindEnv("network.log_transactions")
+	viper.BindEnv("network.faucet.url")
 	viper.BindEnv("network.system.account", "CHAIN_SYSTEM_ACCOUNT")
 	viper.BindEnv("network.system.secret", "CHAIN_SYSTEM_SECRET")
 	viper.BindEnv("network.system.public", "CHAIN_SYSTEM_PUBLIC")
 	viper.BindEnv("features.loan")
+	viper.BindEnv("features.loan_payment_timeout")
+	viper.BindEnv("features.emission_timeout")
+	viper.BindEnv("features.require_balance_check")
+	viper.BindEnv("features.wait_for_validation")
+	viper.BindEnv("features.request_signer_key")
+	viper.BindEnv("metadata.ticker")
+	viper.BindEnv("metadata.name")
+	viper.BindEnv("metadata.desc")
+	viper.BindEnv("metadata.home_url")
+	viper.BindEnv("metadata.rulebook_url")
 
 	// Set default
 	viper.SetDefault("log.level", "info")
@@ -48,7 +71,24 @@ func initConfig() {
 	viper.SetDefault("server.listen", ":8099")
 	viper.SetDefault("network.url", "https://s.altnet.rippletest.net:51234/")
 	viper.SetDefault("network.timeout", 30)
+	viper.SetDefault("network.detect_network_id", true)
+	viper.SetDefault("network.check_amendments", true)
+	viper.SetDefault("network.submit_mode", "fire_and_forget")
+	viper.SetDefault("network.confirmation_depth", 1)
+	viper.SetDefault("network.max_batch_size", 8)
+	viper.SetDefault("network.wallet_cache_size", 1024)
+	viper.SetDefault("network.correlation_memo", false)
+	viper.SetDefault("network.log_transactions", false)
 	viper.SetDefault("features.loan", false)
+	viper.SetDefault("features.loan_payment_timeout", 60)
+	viper.SetDefault("features.emission_timeout", 60)
+	viper.SetDefault("features.require_balance_check", true)
+	viper.SetDefault("features.wait_for_validation", false)
+	viper.SetDefault("metadata.ticker", "FSWRNT")
+	viper.SetDefault("metadata.name", "FortStock Warrant")
+	viper.SetDefault("metadata.desc", "Digital representation of real-world asset-backed warrants")
+	viper.SetDefault("metadata.home_url", "https://fortstock.io")
+	viper.SetDefault("metadata.rulebook_url", "https://fortstock.io/rulebook/")
 
 	if err := viper.ReadInConfig(); err == nil {
 		fmt.Println("Using config file:", viper.ConfigFileUsed())
@@ -65,7 +105,11 @@ var rootCmd = &cobra.Command{
 		}
 		fmt.Println(cfg.RedactedConfigLog())
 
-		server := di.InitializeServer(cfg.LoggerConfig(), cfg.NetworkConfig(), cfg.FeatureConfig())
+		if err := cfg.MetadataDefaults().Validate(); err != nil {
+			return err
+		}
+
+		server := di.InitializeServer(cfg.LoggerConfig(), cfg.NetworkConfig(), cfg.FeatureConfig(), cfg.MetadataDefaults())
 		ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
 		defer stop()
 		if err := server.RunWithGracefulShutdown(ctx, cfg.Server.Listen); err != nil {
@@ -7,10 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 	"strconv"
-	"strings"
 	"time"
 
-	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
 	accountv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/account/v1"
 	typesv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/types/v1"
 )
@@ -36,14 +34,14 @@ func NewAccount(l *slog.Logger, bc *Blockchain) *Account {
 //
 // Returns the created account information or an error if creation fails.
 func (a *Account) Create(ctx context.Context, req *accountv1.CreateRequest) (*accountv1.CreateResponse, error) {
-	l := a.logger.With("method", "Create")
+	l := a.logger.With("method", "Create", "correlation_id", CorrelationID(ctx))
 	l.Debug("start")
-	seeds := strings.Split(req.GetPassword(), "-")
-	if len(seeds) != 2 {
+	hexSeed, index, err := parsePass(req.GetPassword())
+	if err != nil {
 		l.Error("invalid password format", "password", req.GetPassword())
 		return nil, fmt.Errorf("invalid password format: %s", req.GetPassword())
 	}
-	w, err := crypto.NewWalletFromHexSeed(seeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", seeds[1]))
+	w, err := a.bc.WalletFromHexSeedIndex(hexSeed, index)
 	if err != nil {
 		l.Error("failed to get XRPL address", "error", err)
 		return nil, err
@@ -66,10 +64,15 @@ func (a *Account) Create(ctx context.Context, req *accountv1.CreateRequest) (*ac
 //
 // Returns transaction details including the transaction hash and timestamp.
 func (a *Account) Deposit(ctx context.Context, req *accountv1.DepositRequest) (*accountv1.DepositResponse, error) {
-	l := a.logger.With("method", "Deposit", "account", req.GetAccountId())
+	correlationID := CorrelationID(ctx)
+	l := a.logger.With("method", "Deposit", "correlation_id", correlationID, "account", req.GetAccountId())
 	l.Debug("start", "amount", req.GetWeiAmount())
-	a.bc.Lock()
+	if err := a.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer a.bc.Unlock()
+	a.bc.SetCorrelationID(correlationID)
 
 	dropsToTransfer, err := strconv.ParseUint(req.GetWeiAmount(), 10, 64)
 	if err != nil {
@@ -108,17 +111,22 @@ func (a *Account) Deposit(ctx context.Context, req *accountv1.DepositRequest) (*
 //
 // Returns transaction details if successful, or an error if the balance is insufficient.
 func (a *Account) ClearBalance(ctx context.Context, req *accountv1.ClearBalanceRequest) (*accountv1.ClearBalanceResponse, error) {
-	l := a.logger.With("method", "ClearBalance", "account", req.GetAccountId())
+	correlationID := CorrelationID(ctx)
+	l := a.logger.With("method", "ClearBalance", "correlation_id", correlationID, "account", req.GetAccountId())
 	l.Debug("start")
-	a.bc.Lock()
+	if err := a.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer a.bc.Unlock()
+	a.bc.SetCorrelationID(correlationID)
 
-	seeds := strings.Split(req.GetAccountPassword(), "-")
-	if len(seeds) != 2 {
+	hexSeed, index, err := parsePass(req.GetAccountPassword())
+	if err != nil {
 		l.Error("invalid password format", "password", req.GetAccountPassword())
 		return nil, fmt.Errorf("invalid password format: %s", req.GetAccountPassword())
 	}
-	w, err := crypto.NewWalletFromHexSeed(seeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", seeds[1]))
+	w, err := a.bc.WalletFromHexSeedIndex(hexSeed, index)
 	if err != nil {
 		l.Error("failed to get XRPL address", "error", err)
 		return nil, err
@@ -196,12 +204,12 @@ func (a *Account) ClearBalance(ctx context.Context, req *accountv1.ClearBalanceR
 //
 // Returns the account balance as a string representation of drops.
 func (a *Account) GetBalance(ctx context.Context, req *accountv1.GetBalanceRequest) (*accountv1.GetBalanceResponse, error) {
-	l := a.logger.With("method", "GetBalance", "account", req.GetAccountId())
+	l := a.logger.With("method", "GetBalance", "correlation_id", CorrelationID(ctx), "account", req.GetAccountId())
 	l.Debug("start")
 
 	info, err := a.bc.GetAccountInfo(req.GetAccountId())
 	if err != nil {
-		if strings.Contains(err.Error(), "actNotFound") {
+		if isRPCError(err, "actNotFound") {
 			return &accountv1.GetBalanceResponse{
 				Balance: "0",
 			}, nil
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"sync"
+	"time"
+
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+)
+
+// accountInfoCache is a short-lived cache of account_info responses keyed by address.
+// It has its own lock so it can be used both with and without the Blockchain lock held.
+type accountInfoCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]accountInfoCacheEntry
+}
+
+type accountInfoCacheEntry struct {
+	info    account.InfoResponse
+	expires time.Time
+}
+
+func newAccountInfoCache(ttl time.Duration) *accountInfoCache {
+	return &accountInfoCache{ttl: ttl, entries: make(map[string]accountInfoCacheEntry)}
+}
+
+// get returns a copy of the cached response if it has not expired.
+func (c *accountInfoCache) get(address string) (*account.InfoResponse, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[address]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, address)
+		return nil, false
+	}
+	info := entry.info
+	return &info, true
+}
+
+func (c *accountInfoCache) set(address string, info *account.InfoResponse) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[address] = accountInfoCacheEntry{info: *info, expires: time.Now().Add(c.ttl)}
+}
+
+func (c *accountInfoCache) invalidate(address string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, address)
+}
+
+// EnableAccountInfoCache caches GetAccountInfo responses for the given TTL.
+// Entries are dropped whenever the account submits a transaction through this Blockchain.
+//
+// Parameters:
+// - ttl: How long a response stays valid, zero or negative disables the cache
+func (b *Blockchain) EnableAccountInfoCache(ttl time.Duration) {
+	if ttl <= 0 {
+		b.accounts = nil
+		return
+	}
+	b.accounts = newAccountInfoCache(ttl)
+}
+
+// InvalidateAccount drops the cached account info of the address.
+// Callers that change an account out of band (e.g. via another service) should call it.
+//
+// Parameters:
+// - address: The account address to invalidate
+func (b *Blockchain) InvalidateAccount(address string) {
+	b.accounts.invalidate(address)
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_GetAccountInfo_Cache(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.EnableAccountInfoCache(time.Minute)
+
+	_, err := bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+	info, err := bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), info.AccountData.Sequence)
+	assert.Equal(t, 1, node.Calls("account_info"))
+
+	bc.InvalidateAccount(testAddress)
+	_, err = bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, node.Calls("account_info"))
+}
+
+func TestBlockchain_GetAccountInfo_CacheInvalidatedOnSubmit(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.EnableAccountInfoCache(time.Minute)
+
+	_, err := bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+
+	_, err = bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	calls := node.Calls("account_info")
+
+	_, err = bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, calls+1, node.Calls("account_info"))
+}
+
+func TestBlockchain_GetAccountInfo_CacheExpired(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.EnableAccountInfoCache(time.Millisecond)
+
+	_, err := bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, node.Calls("account_info"))
+}
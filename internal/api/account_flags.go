@@ -0,0 +1,83 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AccountRoot flags, mirrored from the ledger-entry-types package where they are unexported.
+const (
+	lsfDefaultRipple                uint32 = 0x00800000
+	lsfDepositAuth                  uint32 = 0x01000000
+	lsfDisableMaster                uint32 = 0x00100000
+	lsfDisallowIncomingCheck        uint32 = 0x08000000
+	lsfDisallowIncomingNFTokenOffer uint32 = 0x04000000
+	lsfDisallowIncomingPayChan      uint32 = 0x10000000
+	lsfDisallowIncomingTrustline    uint32 = 0x20000000
+	lsfDisallowXRP                  uint32 = 0x00080000
+	lsfGlobalFreeze                 uint32 = 0x00400000
+	lsfNoFreeze                     uint32 = 0x00200000
+	lsfPasswordSpent                uint32 = 0x00010000
+	lsfRequireAuth                  uint32 = 0x00040000
+	lsfRequireDestTag               uint32 = 0x00020000
+)
+
+// accountRootFlags maps every AccountRoot flag name to its value.
+var accountRootFlags = map[string]uint32{
+	"lsfAllowTrustLineClawback":       lsfAllowTrustLineClawback,
+	"lsfDefaultRipple":                lsfDefaultRipple,
+	"lsfDepositAuth":                  lsfDepositAuth,
+	"lsfDisableMaster":                lsfDisableMaster,
+	"lsfDisallowIncomingCheck":        lsfDisallowIncomingCheck,
+	"lsfDisallowIncomingNFTokenOffer": lsfDisallowIncomingNFTokenOffer,
+	"lsfDisallowIncomingPayChan":      lsfDisallowIncomingPayChan,
+	"lsfDisallowIncomingTrustline":    lsfDisallowIncomingTrustline,
+	"lsfDisallowXRP":                  lsfDisallowXRP,
+	"lsfGlobalFreeze":                 lsfGlobalFreeze,
+	"lsfNoFreeze":                     lsfNoFreeze,
+	"lsfPasswordSpent":                lsfPasswordSpent,
+	"lsfRequireAuth":                  lsfRequireAuth,
+	"lsfRequireDestTag":               lsfRequireDestTag,
+}
+
+// DecodeAccountFlags maps each AccountRoot flag name to whether it is set in flags.
+func DecodeAccountFlags(flags uint32) map[string]bool {
+	decoded := make(map[string]bool, len(accountRootFlags))
+	for name, flag := range accountRootFlags {
+		decoded[name] = flags&flag != 0
+	}
+	return decoded
+}
+
+// FormatAccountFlags renders decoded account flags sorted by name, one "name=bool" per entry,
+// e.g. "lsfDefaultRipple=true, lsfDepositAuth=false".
+func FormatAccountFlags(flags map[string]bool) string {
+	names := make([]string, 0, len(flags))
+	for name := range flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s=%t", name, flags[name])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// GetAccountFlags reads the AccountRoot flags of an account and decodes them.
+// This helps diagnosing tecNO_AUTH or freeze issues.
+//
+// Parameters:
+// - address: The XRPL account address to query
+//
+// Returns every lsf flag name mapped to whether it is set, or an error if the request fails.
+// Use FormatAccountFlags for a sorted, human-readable form.
+func (b *Blockchain) GetAccountFlags(address string) (map[string]bool, error) {
+	info, err := b.GetAccountInfo(address)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeAccountFlags(info.AccountData.Flags), nil
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_GetAccountFlags(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"account_info": accountInfoResult(testAddress, lsfRequireAuth|lsfDefaultRipple, "1000000000"),
+	})
+
+	flags, err := bc.GetAccountFlags(testAddress)
+	assert.NoError(t, err)
+	assert.Len(t, flags, len(accountRootFlags))
+
+	var set []string
+	for name, ok := range flags {
+		if ok {
+			set = append(set, name)
+		}
+	}
+	assert.ElementsMatch(t, []string{"lsfDefaultRipple", "lsfRequireAuth"}, set)
+}
+
+func TestFormatAccountFlags(t *testing.T) {
+	formatted := FormatAccountFlags(map[string]bool{
+		"lsfRequireAuth":   true,
+		"lsfDepositAuth":   false,
+		"lsfDefaultRipple": true,
+	})
+	assert.Equal(t, "lsfDefaultRipple=true, lsfDepositAuth=false, lsfRequireAuth=true", formatted)
+}
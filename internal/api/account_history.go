@@ -0,0 +1,99 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var (
+	// ErrInvalidMarker is returned when a pagination marker is neither an object nor a string.
+	ErrInvalidMarker = errors.New("invalid pagination marker")
+)
+
+// TxSummary summarizes a transaction of an account's history.
+type TxSummary struct {
+	Hash        string
+	Type        string
+	Result      string
+	LedgerIndex uint64
+}
+
+// GetAccountHistory retrieves one page of the validated transactions involving an account,
+// newest first. The caller pages through the history by passing the returned marker back
+// until it is nil.
+//
+// The node returns the marker as an object, such as {"ledger": 100, "seq": 2}, and some
+// servers as an opaque string. Either is accepted, as is an object marker serialized to
+// JSON text or bytes by a caller that stored it between requests.
+//
+// Parameters:
+// - address: The XRPL account address to query
+// - limit: The most transactions to return, zero or less uses the node default
+// - marker: The marker returned with the previous page, nil for the first page
+//
+// Returns the transactions of the page and the marker of the next page, nil on the last page,
+// an error wrapping ErrInvalidMarker if the marker is malformed, or an error if the request fails.
+func (b *Blockchain) GetAccountHistory(address string, limit int, marker any) (
+	txs []TxSummary, nextMarker any, err error) {
+	marker, err = normalizeMarker(marker)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := b.c.GetAccountTransactions(&account.TransactionsRequest{
+		Account:        types.Address(address),
+		LedgerIndexMin: -1,
+		LedgerIndexMax: -1,
+		Limit:          max(limit, 0),
+		Marker:         marker,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get account transactions: %w", err)
+	}
+
+	txs = make([]TxSummary, 0, len(resp.Transactions))
+	for _, tx := range resp.Transactions {
+		txType, _ := tx.Tx["TransactionType"].(string)
+		txs = append(txs, TxSummary{
+			Hash:        string(tx.Hash),
+			Type:        txType,
+			Result:      tx.Meta.TransactionResult,
+			LedgerIndex: tx.LedgerIndex,
+		})
+	}
+	return txs, resp.Marker, nil
+}
+
+// normalizeMarker returns the marker in the form the node expects, decoding an object
+// marker serialized to JSON.
+func normalizeMarker(marker any) (any, error) {
+	switch m := marker.(type) {
+	case nil, map[string]any:
+		return m, nil
+	case string:
+		if !strings.HasPrefix(strings.TrimSpace(m), "{") {
+			return m, nil
+		}
+		return decodeMarker([]byte(m))
+	case json.RawMessage:
+		return decodeMarker(m)
+	case []byte:
+		return decodeMarker(m)
+	default:
+		return nil, fmt.Errorf("%w: unsupported type %T", ErrInvalidMarker, marker)
+	}
+}
+
+// decodeMarker decodes an object marker serialized to JSON.
+func decodeMarker(data []byte) (any, error) {
+	var marker map[string]any
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMarker, err)
+	}
+	return marker, nil
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// accountHistoryPages answers account_tx with a two-page history, the first page
+// with an object marker and the second, asked for with it, without one.
+func accountHistoryPages(params map[string]any) map[string]any {
+	tx := func(hash, txType, result string, ledgerIndex int) map[string]any {
+		return map[string]any{
+			"hash":         hash,
+			"ledger_index": ledgerIndex,
+			"validated":    true,
+			"meta":         map[string]any{"TransactionResult": result},
+			"tx_json":      map[string]any{"Account": testAddress, "TransactionType": txType},
+		}
+	}
+
+	if params["marker"] == nil {
+		return map[string]any{
+			"account":      testAddress,
+			"transactions": []any{tx("C", "Payment", "tesSUCCESS", 103), tx("B", "TrustSet", "tecNO_LINE", 102)},
+			"marker":       map[string]any{"ledger": 101, "seq": 0},
+			"validated":    true,
+		}
+	}
+	return map[string]any{
+		"account":      testAddress,
+		"transactions": []any{tx("A", "AccountSet", "tesSUCCESS", 101)},
+		"validated":    true,
+	}
+}
+
+func TestBlockchain_GetAccountHistory(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{"account_tx": accountHistoryPages})
+
+	page, marker, err := bc.GetAccountHistory(testAddress, 2, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []TxSummary{
+		{Hash: "C", Type: "Payment", Result: "tesSUCCESS", LedgerIndex: 103},
+		{Hash: "B", Type: "TrustSet", Result: "tecNO_LINE", LedgerIndex: 102},
+	}, page)
+	assert.NotNil(t, marker)
+
+	page, marker, err = bc.GetAccountHistory(testAddress, 2, marker)
+	assert.NoError(t, err)
+	assert.Equal(t, []TxSummary{{Hash: "A", Type: "AccountSet", Result: "tesSUCCESS", LedgerIndex: 101}}, page)
+	assert.Nil(t, marker)
+
+	params := node.Params("account_tx")
+	assert.Equal(t, float64(2), params[0]["limit"])
+	assert.Equal(t, map[string]any{"ledger": float64(101), "seq": float64(0)}, params[1]["marker"])
+}
+
+func TestBlockchain_GetAccountHistory_MarkerForms(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{"account_tx": accountHistoryPages})
+
+	// An object marker stored as JSON text is sent as an object
+	_, _, err := bc.GetAccountHistory(testAddress, 0, `{"ledger":101,"seq":0}`)
+	assert.NoError(t, err)
+	_, _, err = bc.GetAccountHistory(testAddress, 0, []byte(`{"ledger":101,"seq":0}`))
+	assert.NoError(t, err)
+	// An opaque string marker is passed through
+	_, _, err = bc.GetAccountHistory(testAddress, 0, "a1b2c3")
+	assert.NoError(t, err)
+
+	params := node.Params("account_tx")
+	assert.Equal(t, map[string]any{"ledger": float64(101), "seq": float64(0)}, params[0]["marker"])
+	assert.Equal(t, params[0]["marker"], params[1]["marker"])
+	assert.Equal(t, "a1b2c3", params[2]["marker"])
+
+	_, _, err = bc.GetAccountHistory(testAddress, 0, 42)
+	assert.True(t, errors.Is(err, ErrInvalidMarker))
+	_, _, err = bc.GetAccountHistory(testAddress, 0, `{"ledger":`)
+	assert.True(t, errors.Is(err, ErrInvalidMarker))
+	assert.Equal(t, 3, node.Calls("account_tx"))
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var (
+	// ErrAccountTxnIDDisabled is returned when chaining a transaction of an account that does not
+	// track its last transaction, which an AccountSet with asfAccountTxnID enables.
+	ErrAccountTxnIDDisabled = errors.New("account does not track AccountTxnID")
+)
+
+// accountTxnIDs tracks the hash of the last transaction of each account submitted with
+// WithAccountTxnID, the AccountTxnID its next chained transaction must carry.
+// The zero value is ready to use.
+type accountTxnIDs struct {
+	mu     sync.Mutex
+	hashes map[string]string
+}
+
+// get returns the tracked hash of the account's last transaction.
+func (t *accountTxnIDs) get(address string) (string, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	hash, ok := t.hashes[address]
+	return hash, ok
+}
+
+// record updates the account's tracked hash after a submission. A known hash of an applied
+// transaction is tracked when the submission was chained or the account is already tracked;
+// an empty hash, for a transaction whose outcome is unknown, drops the account so that the
+// next chained transaction reads the last hash from the ledger.
+func (t *accountTxnIDs) record(address, hash string, chained bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if hash == "" {
+		delete(t.hashes, address)
+		return
+	}
+	if _, ok := t.hashes[address]; !ok && !chained {
+		return
+	}
+	if t.hashes == nil {
+		t.hashes = make(map[string]string)
+	}
+	t.hashes[address] = hash
+}
+
+// forget drops the account's tracked hash, after a transaction submitted outside of record.
+func (t *accountTxnIDs) forget(address string) {
+	t.record(address, "", false)
+}
+
+// WithAccountTxnID chains the transaction to the previous transaction of the account by setting
+// AccountTxnID to its hash, so the transaction fails with tefWRONG_PRIOR if another transaction
+// of the account was applied in between, or if it is replayed out of order.
+// The account must have asfAccountTxnID set. The previous hash is the last one submitted
+// through this Blockchain, or read from the ledger when it is not known.
+func WithAccountTxnID() SubmitOption {
+	return func(o *submitOptions) {
+		o.accountTxnID = true
+	}
+}
+
+// setAccountTxnID sets AccountTxnID to the hash of the last transaction of the account.
+func (b *Blockchain) setAccountTxnID(tx transactions.FlatTransaction) error {
+	address, _ := tx["Account"].(string)
+	if hash, ok := b.txnIDs.get(address); ok {
+		tx["AccountTxnID"] = hash
+		return nil
+	}
+
+	info, err := b.c.GetAccountInfo(&account.InfoRequest{
+		Account:     types.Address(address),
+		LedgerIndex: common.Current,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get account txn ID: %w", err)
+	}
+	if info.AccountData.AccountTxnID == "" {
+		return fmt.Errorf("%w: %s, enable it with asfAccountTxnID", ErrAccountTxnIDDisabled, address)
+	}
+	tx["AccountTxnID"] = info.AccountData.AccountTxnID.String()
+	return nil
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+const (
+	// defaultWatchInterval is how often watched accounts are polled, about one ledger close.
+	defaultWatchInterval = 4 * time.Second
+	// accountWatchBuffer is the channel capacity of an account watch.
+	accountWatchBuffer = 16
+)
+
+// AccountTx is a validated transaction involving a watched account.
+type AccountTx struct {
+	Hash        string
+	Type        string
+	LedgerIndex uint64
+	Validated   bool
+}
+
+// SetWatchInterval sets how often WatchAccount polls the node for new transactions,
+// and AwaitConfirmationDepth for new validated ledgers.
+//
+// Parameters:
+// - interval: The polling interval, zero uses the default of about one ledger close
+func (b *Blockchain) SetWatchInterval(interval time.Duration) {
+	b.watchInterval = interval
+}
+
+// GetAccountTransactions retrieves the validated transactions involving an account,
+// oldest first, following the pagination markers of the node.
+//
+// Parameters:
+// - address: The XRPL account address to query
+// - minLedger: The first ledger to include, zero for the earliest available
+//
+// Returns the transactions or an error if a request fails. The requests are served by the
+// polling client, so the context of the lock holder does not abort them.
+func (b *Blockchain) GetAccountTransactions(address string, minLedger uint64) ([]account.Transaction, error) {
+	req := &account.TransactionsRequest{
+		Account:        types.Address(address),
+		LedgerIndexMin: int(minLedger),
+		LedgerIndexMax: -1,
+		Forward:        true,
+	}
+
+	var txs []account.Transaction
+	for {
+		resp, err := b.pollClient().GetAccountTransactions(req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account transactions: %w", err)
+		}
+		txs = append(txs, resp.Transactions...)
+		if resp.Marker == nil {
+			return txs, nil
+		}
+		req.Marker = resp.Marker
+	}
+}
+
+// WatchAccount streams the transactions of an account validated after the call.
+// The node is polled over HTTP with account_tx, starting after the latest validated ledger;
+// each transaction is emitted once. Polling errors are logged and retried on the next tick.
+// The channel is closed once the returned cancel function is called.
+//
+// Parameters:
+// - address: The classic address of the account to watch
+//
+// Returns the transaction channel and its cancel function, or an error if the address
+// is invalid or the starting ledger cannot be read.
+func (b *Blockchain) WatchAccount(address string) (<-chan AccountTx, func(), error) {
+	if !addresscodec.IsValidClassicAddress(address) {
+		return nil, nil, fmt.Errorf("invalid account address: %q", address)
+	}
+
+	index, err := b.c.GetLedgerIndex()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get ledger index: %w", err)
+	}
+
+	interval := b.watchInterval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+
+	txs := make(chan AccountTx, accountWatchBuffer)
+	done := make(chan struct{})
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() { close(done) })
+	}
+
+	go b.watchAccount(address, uint64(index.Uint32())+1, interval, txs, done)
+
+	return txs, cancel, nil
+}
+
+// watchAccount polls the account's transactions from minLedger until done is closed.
+// The last polled ledger is requested again in case it gained transactions, so the
+// hashes seen in it are kept to skip duplicates.
+func (b *Blockchain) watchAccount(address string, minLedger uint64, interval time.Duration,
+	txs chan<- AccountTx, done <-chan struct{}) {
+	defer close(txs)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	seen := make(map[string]uint64)
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+
+		found, err := b.GetAccountTransactions(address, minLedger)
+		if err != nil {
+			if b.logger != nil {
+				b.logger.Warn("failed to poll account transactions", "account", address, "error", err)
+			}
+			continue
+		}
+
+		for _, tx := range found {
+			hash := string(tx.Hash)
+			if _, ok := seen[hash]; ok {
+				continue
+			}
+			seen[hash] = tx.LedgerIndex
+			minLedger = max(minLedger, tx.LedgerIndex)
+
+			txType, _ := tx.Tx["TransactionType"].(string)
+			select {
+			case txs <- AccountTx{Hash: hash, Type: txType, LedgerIndex: tx.LedgerIndex, Validated: tx.Validated}:
+			case <-done:
+				return
+			}
+		}
+
+		for hash, ledger := range seen {
+			if ledger < minLedger {
+				delete(seen, hash)
+			}
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// accountTxLedger is a stub account_tx history the test can append to.
+type accountTxLedger struct {
+	mu  sync.Mutex
+	txs []map[string]any
+}
+
+func (l *accountTxLedger) add(hash, txType string, ledgerIndex int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.txs = append(l.txs, map[string]any{
+		"hash":         hash,
+		"ledger_index": ledgerIndex,
+		"validated":    true,
+		"meta":         map[string]any{"TransactionResult": "tesSUCCESS"},
+		"tx_json":      map[string]any{"Account": testAddress, "TransactionType": txType},
+	})
+}
+
+func (l *accountTxLedger) handler(params map[string]any) map[string]any {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	minLedger, _ := params["ledger_index_min"].(float64)
+	var txs []map[string]any
+	for _, tx := range l.txs {
+		if float64(tx["ledger_index"].(int)) >= minLedger {
+			txs = append(txs, tx)
+		}
+	}
+	return map[string]any{"account": testAddress, "transactions": txs, "validated": true}
+}
+
+func receiveAccountTx(t *testing.T, txs <-chan AccountTx) AccountTx {
+	t.Helper()
+	select {
+	case tx := <-txs:
+		return tx
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for account transaction")
+		return AccountTx{}
+	}
+}
+
+func TestBlockchain_WatchAccount(t *testing.T) {
+	history := &accountTxLedger{}
+	history.add("OLD", "Payment", 100)
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger": func(params map[string]any) map[string]any {
+			return map[string]any{"ledger_index": 100, "validated": true}
+		},
+		"account_tx": history.handler,
+	})
+	bc.SetWatchInterval(10 * time.Millisecond)
+
+	txs, cancel, err := bc.WatchAccount(testAddress)
+	assert.NoError(t, err)
+
+	history.add("A", "Payment", 101)
+	assert.Equal(t, AccountTx{Hash: "A", Type: "Payment", LedgerIndex: 101, Validated: true}, receiveAccountTx(t, txs))
+
+	history.add("B", "TrustSet", 101)
+	history.add("C", "CheckCreate", 102)
+	assert.Equal(t, "B", receiveAccountTx(t, txs).Hash)
+	assert.Equal(t, "C", receiveAccountTx(t, txs).Hash)
+
+	// Further polls return A, B and C again but must not emit them
+	calls := node.Calls("account_tx")
+	assert.Eventually(t, func() bool { return node.Calls("account_tx") > calls+2 }, 2*time.Second, 5*time.Millisecond)
+	select {
+	case tx := <-txs:
+		t.Fatalf("unexpected duplicate transaction %s", tx.Hash)
+	default:
+	}
+
+	params := node.Params("account_tx")
+	assert.Equal(t, float64(101), params[0]["ledger_index_min"])
+	assert.Equal(t, float64(102), params[len(params)-1]["ledger_index_min"])
+
+	cancel()
+	cancel()
+	assert.Eventually(t, func() bool {
+		_, ok := <-txs
+		return !ok
+	}, 2*time.Second, 5*time.Millisecond)
+}
+
+func TestBlockchain_WatchAccount_InvalidAddress(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	_, _, err := bc.WatchAccount("not-an-address")
+	assert.Error(t, err)
+	assert.Equal(t, 0, node.TotalCalls())
+}
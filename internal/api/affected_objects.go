@@ -0,0 +1,86 @@
+package api
+
+import (
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	typesv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/types/v1"
+)
+
+// AffectedNodeType tells how a transaction affected a ledger object.
+type AffectedNodeType string
+
+const (
+	// CreatedNodeType is a ledger object the transaction created.
+	CreatedNodeType AffectedNodeType = "CreatedNode"
+	// ModifiedNodeType is a ledger object the transaction modified.
+	ModifiedNodeType AffectedNodeType = "ModifiedNode"
+	// DeletedNodeType is a ledger object the transaction deleted.
+	DeletedNodeType AffectedNodeType = "DeletedNode"
+)
+
+// AffectedObjectSummary identifies a ledger object affected by a transaction.
+type AffectedObjectSummary struct {
+	// NodeType tells whether the object was created, modified or deleted.
+	NodeType AffectedNodeType
+	// LedgerEntryType is the type of the object, such as AccountRoot or MPToken.
+	LedgerEntryType string
+	// LedgerIndex is the ID of the object.
+	LedgerIndex string
+}
+
+// GetAffectedObjects lists the ledger objects a transaction created, modified or deleted,
+// in metadata order. Nodes of none of the three kinds are skipped.
+//
+// Parameters:
+// - meta: The transaction metadata
+//
+// Returns the affected objects, empty if the metadata is nil or lists none.
+func GetAffectedObjects(meta *transactions.TxObjMeta) []AffectedObjectSummary {
+	if meta == nil {
+		return nil
+	}
+
+	objects := make([]AffectedObjectSummary, 0, len(meta.AffectedNodes))
+	for _, node := range meta.AffectedNodes {
+		switch {
+		case node.CreatedNode != nil:
+			objects = append(objects, AffectedObjectSummary{
+				NodeType:        CreatedNodeType,
+				LedgerEntryType: string(node.CreatedNode.LedgerEntryType),
+				LedgerIndex:     node.CreatedNode.LedgerIndex,
+			})
+		case node.ModifiedNode != nil:
+			objects = append(objects, AffectedObjectSummary{
+				NodeType:        ModifiedNodeType,
+				LedgerEntryType: string(node.ModifiedNode.LedgerEntryType),
+				LedgerIndex:     node.ModifiedNode.LedgerIndex,
+			})
+		case node.DeletedNode != nil:
+			objects = append(objects, AffectedObjectSummary{
+				NodeType:        DeletedNodeType,
+				LedgerEntryType: string(node.DeletedNode.LedgerEntryType),
+				LedgerIndex:     node.DeletedNode.LedgerIndex,
+			})
+		}
+	}
+	return objects
+}
+
+// affectedObjectEvents converts affected objects into "AffectedObject" transaction events.
+func affectedObjectEvents(objects []AffectedObjectSummary) []*typesv1.Event {
+	if len(objects) == 0 {
+		return nil
+	}
+
+	events := make([]*typesv1.Event, len(objects))
+	for i, object := range objects {
+		events[i] = &typesv1.Event{
+			Name: "AffectedObject",
+			Values: []*typesv1.EventValue{
+				{Name: "node_type", Value: string(object.NodeType)},
+				{Name: "ledger_entry_type", Value: object.LedgerEntryType},
+				{Name: "ledger_index", Value: object.LedgerIndex},
+			},
+		}
+	}
+	return events
+}
@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+	tokenv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/token/v1"
+)
+
+// mptAuthorizeTxFixture is a tx result for a holder opting in to an MPT issuance, which
+// creates the MPToken, modifies the holder account and deletes an offer.
+const mptAuthorizeTxFixture = `{
+	"hash": "E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7",
+	"ledger_index": 56865245,
+	"date": 750000000,
+	"validated": true,
+	"meta": {
+		"AffectedNodes": [
+			{
+				"CreatedNode": {
+					"LedgerEntryType": "MPToken",
+					"LedgerIndex": "DFE3B5D0D7B86D0D4B9B2B8E1FDE4C3A5F6F0D5A1E24D2B2A3D7A0E8D0A5C8B1",
+					"NewFields": {
+						"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+						"MPTokenIssuanceID": "0000012FFD9EE5DA93AC614B4DB94D7E0FCE415CA51BED47"
+					}
+				}
+			},
+			{
+				"ModifiedNode": {
+					"LedgerEntryType": "AccountRoot",
+					"LedgerIndex": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+					"FinalFields": {
+						"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+						"OwnerCount": 1
+					},
+					"PreviousFields": {
+						"OwnerCount": 0
+					}
+				}
+			},
+			{
+				"DeletedNode": {
+					"LedgerEntryType": "Offer",
+					"LedgerIndex": "A8A9A4A2D9A3B8E6C4E3F1E2D6C9B8A7F3E2D1C0B9A8F7E6D5C4B3A2F1E0D9C8",
+					"FinalFields": {
+						"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+					}
+				}
+			}
+		],
+		"TransactionIndex": 3,
+		"TransactionResult": "tesSUCCESS"
+	},
+	"tx_json": {
+		"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Fee": "12",
+		"Flags": 0,
+		"MPTokenIssuanceID": "0000012FFD9EE5DA93AC614B4DB94D7E0FCE415CA51BED47",
+		"Sequence": 2,
+		"SigningPubKey": "03AB40A0490F9B7ED8DF29D246BF2D6269820A0EE7742ACDD457BEA7C7D0931EDB",
+		"TransactionType": "MPTokenAuthorize",
+		"TxnSignature": "3045022100"
+	}
+}`
+
+// mptAuthorizeAffectedObjects are the objects affected by mptAuthorizeTxFixture.
+var mptAuthorizeAffectedObjects = []AffectedObjectSummary{
+	{CreatedNodeType, "MPToken", "DFE3B5D0D7B86D0D4B9B2B8E1FDE4C3A5F6F0D5A1E24D2B2A3D7A0E8D0A5C8B1"},
+	{ModifiedNodeType, "AccountRoot", "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8"},
+	{DeletedNodeType, "Offer", "A8A9A4A2D9A3B8E6C4E3F1E2D6C9B8A7F3E2D1C0B9A8F7E6D5C4B3A2F1E0D9C8"},
+}
+
+func TestGetAffectedObjects(t *testing.T) {
+	var tx struct {
+		Meta transactions.TxObjMeta `json:"meta"`
+	}
+	if err := json.Unmarshal([]byte(mptAuthorizeTxFixture), &tx); err != nil {
+		t.Fatalf("failed to decode fixture: %v", err)
+	}
+
+	assert.Equal(t, mptAuthorizeAffectedObjects, GetAffectedObjects(&tx.Meta))
+	assert.Empty(t, GetAffectedObjects(&transactions.TxObjMeta{}))
+	assert.Nil(t, GetAffectedObjects(nil))
+}
+
+func TestToken_TransactionInfo_AffectedObjects(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"tx": fixtureResult(mptAuthorizeTxFixture),
+	})
+	tokenAPI := createTestToken(bc)
+
+	resp, err := tokenAPI.TransactionInfo(context.Background(), &tokenv1.TransactionInfoRequest{
+		TransactionId: "E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	events := resp.GetTransaction().GetEvents()
+	if assert.Len(t, events, len(mptAuthorizeAffectedObjects)) {
+		for i, want := range mptAuthorizeAffectedObjects {
+			assert.Equal(t, "AffectedObject", events[i].GetName())
+			values := map[string]string{}
+			for _, v := range events[i].GetValues() {
+				values[v.GetName()] = v.GetValue()
+			}
+			assert.Equal(t, map[string]string{
+				"node_type":         string(want.NodeType),
+				"ledger_entry_type": want.LedgerEntryType,
+				"ledger_index":      want.LedgerIndex,
+			}, values)
+		}
+	}
+}
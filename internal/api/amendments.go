@@ -0,0 +1,49 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+)
+
+var (
+	// ErrMissingAmendments is returned when the node does not have every amendment the service relies on enabled.
+	ErrMissingAmendments = errors.New("required amendments not enabled")
+)
+
+// RequiredAmendments are the amendments the service relies on, by name.
+// Without MPTokensV1 every MPT transaction fails with temDISABLED.
+var RequiredAmendments = []string{"MPTokensV1"}
+
+// CheckRequiredAmendments confirms the node has every amendment in RequiredAmendments enabled,
+// so that a node on an unsuitable network is rejected at startup instead of failing on use.
+//
+// Returns an error wrapping ErrMissingAmendments listing the amendments that are unknown to
+// the node or not enabled, or an error if the features cannot be read.
+func (b *Blockchain) CheckRequiredAmendments() error {
+	resp, err := b.c.GetAllFeatures(&server.FeatureAllRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get amendments: %w", err)
+	}
+
+	enabled := make(map[string]bool, len(resp.Features))
+	for _, feature := range resp.Features {
+		if feature.Enabled {
+			enabled[feature.Name] = true
+		}
+	}
+
+	var missing []string
+	for _, name := range RequiredAmendments {
+		if !enabled[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingAmendments, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
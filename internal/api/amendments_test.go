@@ -0,0 +1,76 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+)
+
+// featuresResult returns a feature handler reporting the named amendments with the given enabled state.
+func featuresResult(amendments map[string]bool) rpcHandler {
+	return func(params map[string]any) map[string]any {
+		features := map[string]any{
+			// Always present, never required
+			"42426C4D4F1009EE67080A9B7965B44656D7714D104A72F9B4369F97ABF044EE": map[string]any{
+				"enabled": true, "name": "FeeEscalation", "supported": true,
+			},
+		}
+		for name, enabled := range amendments {
+			features["ID_"+name] = map[string]any{"enabled": enabled, "name": name, "supported": true}
+		}
+		return map[string]any{"features": features}
+	}
+}
+
+func TestBlockchain_CheckRequiredAmendments(t *testing.T) {
+	tests := []struct {
+		name       string
+		amendments map[string]bool
+		wantErr    bool
+	}{
+		{name: "enabled", amendments: map[string]bool{"MPTokensV1": true}},
+		{name: "not enabled", amendments: map[string]bool{"MPTokensV1": false}, wantErr: true},
+		{name: "unknown to the node", amendments: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+				"feature": featuresResult(tt.amendments),
+			})
+
+			err := bc.CheckRequiredAmendments()
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrMissingAmendments), "got %v", err)
+				assert.ErrorContains(t, err, "MPTokensV1")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlockchain_CheckRequiredAmendments_NodeError(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{})
+
+	err := bc.CheckRequiredAmendments()
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrMissingAmendments))
+}
+
+func TestNewBlockchain_CheckAmendments(t *testing.T) {
+	_, srv := newStubNode(t, map[string]rpcHandler{
+		"feature": featuresResult(nil),
+	})
+
+	cfg := config.NetworkConfig{URL: srv.URL, Timeout: 5, CheckAmendments: true}
+	setTestSystemAccount(&cfg)
+
+	_, err := NewBlockchain(cfg)
+	assert.True(t, errors.Is(err, ErrMissingAmendments), "got %v", err)
+
+	cfg.CheckAmendments = false
+	_, err = NewBlockchain(cfg)
+	assert.NoError(t, err)
+}
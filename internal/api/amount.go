@@ -0,0 +1,100 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	bctypes "github.com/Peersyst/xrpl-go/binary-codec/types"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrInvalidCurrencyCode is returned when a currency code is neither a 3-char standard code nor a 40-char hex code.
+	ErrInvalidCurrencyCode = errors.New("invalid currency code")
+)
+
+// NewIssuedAmount builds an issued currency amount accepting both standard
+// 3-character codes (e.g. "USD") and non-standard 40-character hex codes
+// (e.g. RLUSDHex). The amount is serialized with the binary codec so that
+// anything the node would reject fails here instead.
+//
+// Parameters:
+// - value: The decimal amount
+// - currency: The currency code (3-char or 40-char hex, optional 0x prefix)
+// - issuer: The issuer account address
+//
+// Returns the amount, an error wrapping ErrInvalidCurrencyCode for a malformed code,
+// or an error if the value or issuer is invalid.
+func NewIssuedAmount(value, currency, issuer string) (types.CurrencyAmount, error) {
+	code := strings.TrimPrefix(currency, "0x")
+	if len(code) != 3 && len(code) != 40 {
+		return nil, fmt.Errorf("%w: %q must be 3 characters or 40 hex characters, got %d", ErrInvalidCurrencyCode, currency, len(code))
+	}
+
+	if _, err := (&bctypes.Amount{}).FromJSON(map[string]any{
+		"value":    value,
+		"currency": code,
+		"issuer":   issuer,
+	}); err != nil {
+		var codeErr *bctypes.InvalidCodeError
+		if errors.As(err, &codeErr) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidCurrencyCode, err)
+		}
+		return nil, fmt.Errorf("invalid issued amount: %w", err)
+	}
+
+	return types.IssuedCurrencyAmount{
+		Issuer:   types.Address(issuer),
+		Currency: code,
+		Value:    value,
+	}, nil
+}
+
+// issuedValuePrecision is the number of significant digits an issued currency amount holds.
+const issuedValuePrecision = 15
+
+// formatIssuedValue formats an amount as an issued currency value, keeping no more
+// significant digits than an issued currency amount holds.
+func formatIssuedValue(amount float64) string {
+	value, err := decimal.NewFromString(strconv.FormatFloat(amount, 'g', issuedValuePrecision, 64))
+	if err != nil {
+		// NaN or infinity, left for NewIssuedAmount to reject
+		return strconv.FormatFloat(amount, 'f', -1, 64)
+	}
+	return value.String()
+}
+
+// amountValue returns the numeric value of an amount, in drops for XRP.
+func amountValue(amount types.CurrencyAmount) (decimal.Decimal, error) {
+	switch a := amount.(type) {
+	case types.XRPCurrencyAmount:
+		return decimal.NewFromUint64(uint64(a)), nil
+	case types.IssuedCurrencyAmount:
+		return decimal.NewFromString(a.Value)
+	case types.MPTCurrencyAmount:
+		return decimal.NewFromString(a.Value)
+	default:
+		return decimal.Zero, fmt.Errorf("unsupported amount type %T", amount)
+	}
+}
+
+// sameAsset reports whether both amounts are denominated in the same asset:
+// XRP, the same issued currency and issuer, or the same MPT issuance.
+func sameAsset(a, b types.CurrencyAmount) bool {
+	switch x := a.(type) {
+	case types.XRPCurrencyAmount:
+		_, ok := b.(types.XRPCurrencyAmount)
+		return ok
+	case types.IssuedCurrencyAmount:
+		y, ok := b.(types.IssuedCurrencyAmount)
+		return ok && x.Currency == y.Currency && x.Issuer == y.Issuer
+	case types.MPTCurrencyAmount:
+		y, ok := b.(types.MPTCurrencyAmount)
+		return ok && x.MPTIssuanceID == y.MPTIssuanceID
+	default:
+		return false
+	}
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIssuedAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		currency string
+		want     string
+	}{
+		{name: "standard code", currency: "USD", want: "USD"},
+		{name: "hex code", currency: RLUSDHex, want: RLUSDHex},
+		{name: "hex code with prefix", currency: "0x" + RLUSDHex, want: RLUSDHex},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, err := NewIssuedAmount("10.5", tt.currency, testAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, types.IssuedCurrencyAmount{
+				Issuer:   types.Address(testAddress),
+				Currency: tt.want,
+				Value:    "10.5",
+			}, amount)
+		})
+	}
+}
+
+func TestNewIssuedAmount_InvalidCurrency(t *testing.T) {
+	for _, currency := range []string{"", "RLUSD", RLUSDHex[:39], "XRP"} {
+		_, err := NewIssuedAmount("10", currency, testAddress)
+		assert.True(t, errors.Is(err, ErrInvalidCurrencyCode), "currency %q: %v", currency, err)
+	}
+
+	_, err := NewIssuedAmount("10", "ZZ"+RLUSDHex[2:], testAddress)
+	assert.Error(t, err)
+	_, err = NewIssuedAmount("10", "USD", "not-an-address")
+	assert.Error(t, err)
+}
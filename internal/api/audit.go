@@ -0,0 +1,151 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// AuditEntry is a record of a single transaction submitted by the service.
+type AuditEntry struct {
+	Time          time.Time `json:"time"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+	TxType        string    `json:"tx_type"`
+	Account       string    `json:"account"`
+	Fee           string    `json:"fee"`
+	Hash          string    `json:"hash"`
+	// PrevHash is the SHA-256 of the previous record, set by FileAuditor.
+	PrevHash string `json:"prev_hash,omitempty"`
+}
+
+// TxAuditor records every transaction submitted by the service.
+type TxAuditor interface {
+	Record(entry AuditEntry) error
+}
+
+// FileAuditor is an append-only TxAuditor writing one JSON record per line.
+// Each record carries the SHA-256 of the previous line, so removing or editing
+// a record breaks the chain and is detectable.
+type FileAuditor struct {
+	mu       sync.Mutex
+	f        *os.File
+	prevHash string
+}
+
+// NewFileAuditor opens or creates the audit log at the given path for appending.
+// The hash chain is resumed from the last record of an existing file.
+//
+// Parameters:
+// - path: The audit log file path
+//
+// Returns the auditor or an error if the file cannot be opened or read.
+func NewFileAuditor(path string) (*FileAuditor, error) {
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+
+	var prevHash string
+	if last := lastLine(data); len(last) > 0 {
+		sum := sha256.Sum256(last)
+		prevHash = hex.EncodeToString(sum[:])
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+
+	return &FileAuditor{f: f, prevHash: prevHash}, nil
+}
+
+// lastLine returns the last non-empty line of data.
+func lastLine(data []byte) []byte {
+	var last []byte
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if line := scanner.Bytes(); len(line) > 0 {
+			last = append(last[:0], line...)
+		}
+	}
+	return last
+}
+
+// Record appends the entry to the audit log and syncs it to disk.
+func (a *FileAuditor) Record(entry AuditEntry) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	entry.PrevHash = a.prevHash
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	if _, err := a.f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	if err := a.f.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+
+	sum := sha256.Sum256(line)
+	a.prevHash = hex.EncodeToString(sum[:])
+	return nil
+}
+
+// Close closes the audit log file.
+func (a *FileAuditor) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.f.Close()
+}
+
+// SetAuditor injects the auditor called after every successful submission.
+// Audit failures are logged with the given logger and never fail the transaction.
+//
+// Parameters:
+// - auditor: The auditor to record submissions with, nil disables auditing
+// - logger: The logger for audit failures
+func (b *Blockchain) SetAuditor(auditor TxAuditor, logger *slog.Logger) {
+	b.auditor = auditor
+	b.logger = logger
+}
+
+// SetCorrelationID sets the correlation ID of the request being served, see CorrelationID.
+// It is recorded with every transaction submitted until Unlock, and carried in their memo
+// when EnableCorrelationMemo is on, so it must be called with the lock held.
+func (b *Blockchain) SetCorrelationID(id string) {
+	b.correlationID = id
+}
+
+// audit records a submitted transaction if an auditor is set.
+func (b *Blockchain) audit(txType transactions.TxType, address string, submitted transactions.FlatTransaction) {
+	if b.auditor == nil {
+		return
+	}
+
+	hash, _ := submitted["hash"].(string)
+	fee, _ := submitted["Fee"].(string)
+	err := b.auditor.Record(AuditEntry{
+		Time:          time.Now().UTC(),
+		CorrelationID: b.correlationID,
+		TxType:        string(txType),
+		Account:       address,
+		Fee:           fee,
+		Hash:          hash,
+	})
+	if err != nil && b.logger != nil {
+		b.logger.Error("failed to record audit entry", "hash", hash, "error", err)
+	}
+}
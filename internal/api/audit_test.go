@@ -0,0 +1,155 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryAuditor keeps audit entries in memory and optionally fails every write.
+type memoryAuditor struct {
+	entries []AuditEntry
+	err     error
+}
+
+func (a *memoryAuditor) Record(entry AuditEntry) error {
+	if a.err != nil {
+		return a.err
+	}
+	a.entries = append(a.entries, entry)
+	return nil
+}
+
+func TestBlockchain_SubmitTx_Audit(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	auditor := &memoryAuditor{}
+	bc.SetAuditor(auditor, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	bc.Lock()
+	bc.SetCorrelationID("document-hash")
+	hash1, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	hash2, _, err := bc.SubmitTxWithSequence(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	bc.Unlock()
+
+	if assert.Len(t, auditor.entries, 2) {
+		for i, hash := range []string{hash1, hash2} {
+			entry := auditor.entries[i]
+			assert.Equal(t, hash, entry.Hash)
+			assert.Equal(t, "document-hash", entry.CorrelationID)
+			assert.Equal(t, "AccountSet", entry.TxType)
+			assert.Equal(t, testAddress, entry.Account)
+			assert.NotEmpty(t, entry.Fee)
+		}
+	}
+	assert.Empty(t, bc.correlationID)
+}
+
+func TestBlockchain_SubmitTxAndWait_Audit(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	auditor := &memoryAuditor{}
+	bc.SetAuditor(auditor, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	bc.Lock()
+	bc.SetCorrelationID("document-hash")
+	hash, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	bc.Unlock()
+
+	if assert.Len(t, auditor.entries, 1) {
+		entry := auditor.entries[0]
+		assert.Equal(t, hash, entry.Hash)
+		assert.Equal(t, "document-hash", entry.CorrelationID)
+		assert.Equal(t, "AccountSet", entry.TxType)
+		assert.Equal(t, testAddress, entry.Account)
+		assert.NotEmpty(t, entry.Fee)
+	}
+
+	// A submission the node rejects is not audited
+	bc, _ = newTestBlockchain(t, submitHandlers("tecNO_DST"))
+	bc.SetAuditor(auditor, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	_, err = bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.Error(t, err)
+	assert.Len(t, auditor.entries, 1)
+}
+
+func TestBlockchain_SubmitTx_AuditFailure(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetAuditor(&memoryAuditor{err: errors.New("disk full")}, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	hash, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+}
+
+func TestFileAuditor_HashChain(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	auditor, err := NewFileAuditor(path)
+	if err != nil {
+		t.Fatalf("failed to open audit log: %v", err)
+	}
+	assert.NoError(t, auditor.Record(AuditEntry{Hash: "A"}))
+	assert.NoError(t, auditor.Record(AuditEntry{Hash: "B"}))
+	assert.NoError(t, auditor.Close())
+
+	// Reopening resumes the chain from the last record
+	auditor, err = NewFileAuditor(path)
+	if err != nil {
+		t.Fatalf("failed to reopen audit log: %v", err)
+	}
+	assert.NoError(t, auditor.Record(AuditEntry{Hash: "C"}))
+	assert.NoError(t, auditor.Close())
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read audit log: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if !assert.Len(t, lines, 3) {
+		return
+	}
+
+	prevHash := ""
+	for i, line := range lines {
+		var entry AuditEntry
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		assert.Equal(t, string(rune('A'+i)), entry.Hash)
+		assert.Equal(t, prevHash, entry.PrevHash)
+
+		sum := sha256.Sum256([]byte(line))
+		prevHash = hex.EncodeToString(sum[:])
+	}
+}
+
+func TestBlockchain_SubmitBlob_Audit(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	auditor := &memoryAuditor{}
+	bc.SetAuditor(auditor, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	blob, _, err := bc.w.Sign(testPaymentFlatTx())
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	hash, err := bc.SubmitBlob(blob, false)
+	assert.NoError(t, err)
+
+	if assert.Len(t, auditor.entries, 1) {
+		entry := auditor.entries[0]
+		assert.Equal(t, hash, entry.Hash)
+		assert.Equal(t, "Payment", entry.TxType)
+		assert.Equal(t, testAddress, entry.Account)
+		assert.Equal(t, "12", entry.Fee)
+	}
+}
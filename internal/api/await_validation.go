@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// awaitConcurrency bounds how many transactions AwaitAll polls at the same time.
+const awaitConcurrency = 8
+
+// AwaitValidation waits until a transaction is validated, polling the node about once
+// a ledger close until the context is done. The transaction is looked up once before
+// polling, so that an already validated transaction, such as a retried mint or a
+// historical hash being reconciled, returns without waiting.
+//
+// Parameters:
+// - ctx: Bounds the wait
+// - hash: The transaction hash
+//
+// Returns the validated transaction, an *EngineError if it was validated with a failure
+// result, or an error wrapping the context error, and the last lookup error if any,
+// if the context is done first.
+func (b *Blockchain) AwaitValidation(ctx context.Context, hash string) (*requests.TxResponse, error) {
+	resp, meta, _, lastErr := b.GetTransactionInfo(hash)
+	if lastErr == nil && resp.Validated {
+		return validatedResult(resp, meta)
+	}
+
+	interval := b.watchInterval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return nil, fmt.Errorf("%w: %v", ctx.Err(), lastErr)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		resp, meta, _, err := b.GetTransactionInfo(hash)
+		if err != nil {
+			// The transaction may not have reached the node's ledgers yet
+			lastErr = err
+			continue
+		}
+		if resp.Validated {
+			return validatedResult(resp, meta)
+		}
+	}
+}
+
+// validatedResult returns a validated transaction, or an *EngineError if its result is a failure.
+func validatedResult(resp *requests.TxResponse, meta transactions.TxObjMeta) (*requests.TxResponse, error) {
+	if meta.TransactionResult != string(transactions.TesSUCCESS) {
+		return resp, &EngineError{Result: transactions.TxResult(meta.TransactionResult)}
+	}
+	return resp, nil
+}
+
+// AwaitAll waits for several transactions to be validated, polling up to awaitConcurrency
+// of them at the same time, such as the transactions of a multi-step flow.
+//
+// Parameters:
+// - ctx: Bounds the wait of every transaction
+// - hashes: The transaction hashes
+//
+// Returns the validated transactions by hash, and an error joining, per hash, the error
+// of every transaction that failed or was not validated before the context was done.
+// Validated transactions are returned even when others fail.
+func (b *Blockchain) AwaitAll(ctx context.Context, hashes []string) (map[string]*requests.TxResponse, error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]*requests.TxResponse, len(hashes))
+		errs    []error
+		sem     = make(chan struct{}, awaitConcurrency)
+	)
+
+	for _, hash := range hashes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", hash, ctx.Err()))
+				mu.Unlock()
+				return
+			}
+
+			resp, err := b.AwaitValidation(ctx, hash)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", hash, err))
+				return
+			}
+			results[hash] = resp
+		}()
+	}
+	wg.Wait()
+
+	return results, errors.Join(errs...)
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_AwaitAll(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetWatchInterval(10 * time.Millisecond)
+
+	var hashes []string
+	for i := 0; i < 3; i++ {
+		hash, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+		assert.NoError(t, err)
+		hashes = append(hashes, hash)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	results, err := bc.AwaitAll(ctx, hashes)
+	assert.NoError(t, err)
+	assert.Len(t, results, len(hashes))
+	for _, hash := range hashes {
+		if assert.Contains(t, results, hash) {
+			assert.True(t, results[hash].Validated)
+		}
+	}
+}
+
+func TestBlockchain_AwaitAll_Timeout(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetWatchInterval(10 * time.Millisecond)
+
+	validated, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	missing := "E3FE6EA3D48F0C2B639448020EA4F03D4F4F8FFDB243A852A0F59177921B4879"
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	results, err := bc.AwaitAll(ctx, []string{validated, missing})
+
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+	assert.Contains(t, err.Error(), missing)
+	assert.NotContains(t, err.Error(), validated)
+	assert.Contains(t, results, validated)
+	assert.NotContains(t, results, missing)
+}
+
+func TestBlockchain_AwaitValidation_AlreadyValidated(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	// A poll would never come within the test timeout
+	bc.SetWatchInterval(time.Hour)
+
+	hash, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	resp, err := bc.AwaitValidation(ctx, hash)
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.True(t, resp.Validated)
+	}
+	assert.Equal(t, 1, node.Calls("tx"))
+}
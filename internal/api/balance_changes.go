@@ -0,0 +1,36 @@
+package api
+
+import (
+	"fmt"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// GetAccountBalanceChange returns the XRP and trust line balance changes of a single account
+// in a transaction, sparing callers that only check the sender or receiver from filtering
+// the changes of every account.
+//
+// Parameters:
+// - meta: The transaction metadata
+// - account: The account whose changes are returned
+//
+// Returns the account's balance changes, nil if the transaction did not change its balances,
+// or an error if the metadata cannot be parsed.
+func GetAccountBalanceChange(meta *transactions.TxObjMeta, account types.Address) (*transactions.AccountBalanceChanges, error) {
+	if meta == nil {
+		return nil, nil
+	}
+
+	changes, err := transactions.GetBalanceChanges(meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance changes: %w", err)
+	}
+
+	for i := range changes {
+		if changes[i].Account == account {
+			return &changes[i], nil
+		}
+	}
+	return nil, nil
+}
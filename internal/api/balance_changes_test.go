@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// xrpPaymentMetaFixture is the metadata of a 1 XRP payment from testAddress
+// to rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe with a 12 drop fee.
+const xrpPaymentMetaFixture = `{
+	"AffectedNodes": [
+		{
+			"ModifiedNode": {
+				"LedgerEntryType": "AccountRoot",
+				"LedgerIndex": "13F1A95D7AAB7108D5CE7EEAF504B2894B8C674E6D68499076441C4837282BF8",
+				"FinalFields": {
+					"Account": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+					"Balance": "98999988",
+					"Sequence": 3
+				},
+				"PreviousFields": {
+					"Balance": "100000000",
+					"Sequence": 2
+				}
+			}
+		},
+		{
+			"ModifiedNode": {
+				"LedgerEntryType": "AccountRoot",
+				"LedgerIndex": "A8A9A4A2D9A3B8E6C4E3F1E2D6C9B8A7F3E2D1C0B9A8F7E6D5C4B3A2F1E0D9C8",
+				"FinalFields": {
+					"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+					"Balance": "21000000",
+					"Sequence": 1
+				},
+				"PreviousFields": {
+					"Balance": "20000000"
+				}
+			}
+		}
+	],
+	"TransactionIndex": 3,
+	"TransactionResult": "tesSUCCESS"
+}`
+
+func TestGetAccountBalanceChange(t *testing.T) {
+	var meta transactions.TxObjMeta
+	if err := json.Unmarshal([]byte(xrpPaymentMetaFixture), &meta); err != nil {
+		t.Fatalf("failed to decode fixture: %v", err)
+	}
+
+	sender, err := GetAccountBalanceChange(&meta, types.Address(testAddress))
+	if assert.NoError(t, err) && assert.NotNil(t, sender) {
+		assert.Equal(t, []transactions.Balance{{Value: "-1.000012", Currency: "XRP"}}, sender.Balances)
+	}
+
+	receiver, err := GetAccountBalanceChange(&meta, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe")
+	if assert.NoError(t, err) && assert.NotNil(t, receiver) {
+		assert.Equal(t, []transactions.Balance{{Value: "1", Currency: "XRP"}}, receiver.Balances)
+	}
+
+	uninvolved, err := GetAccountBalanceChange(&meta, "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE")
+	assert.NoError(t, err)
+	assert.Nil(t, uninvolved)
+
+	none, err := GetAccountBalanceChange(nil, types.Address(testAddress))
+	assert.NoError(t, err)
+	assert.Nil(t, none)
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+var (
+	// ErrMalformedBlob is returned when a transaction blob is not an even length hex string.
+	ErrMalformedBlob = errors.New("malformed transaction blob")
+)
+
+// DecodedBlob is a transaction blob decoded for inspection.
+type DecodedBlob struct {
+	// Tx is the transaction in its JSON form.
+	Tx map[string]any
+	// Hash is the hash the node assigns to the blob when it is submitted as is,
+	// empty if the blob is not signed.
+	Hash string
+	// TxType is the transaction type, empty if the blob does not carry one.
+	TxType transactions.TxType
+	// Signed reports whether the blob carries a single signature or signers.
+	Signed bool
+	// Multisigned reports whether the blob carries signers.
+	Multisigned bool
+}
+
+// JSON returns the transaction indented for reading.
+//
+// Returns the indented JSON form of the transaction, or an error if it cannot be encoded.
+func (d *DecodedBlob) JSON() (string, error) {
+	out, err := json.MarshalIndent(d.Tx, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode transaction: %w", err)
+	}
+	return string(out), nil
+}
+
+// DecodeBlob decodes a raw transaction blob, signed or not, into its JSON form,
+// so that blobs received from clients can be inspected.
+//
+// Parameters:
+// - blob: The hex encoded transaction
+//
+// Returns the decoded transaction, an error wrapping ErrMalformedBlob if the blob is not hex,
+// or an error if it does not decode as a transaction.
+func (b *Blockchain) DecodeBlob(blob string) (map[string]any, error) {
+	if blob == "" {
+		return nil, fmt.Errorf("%w: blob is empty", ErrMalformedBlob)
+	}
+	if _, err := hex.DecodeString(blob); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedBlob, err)
+	}
+
+	tx, err := binarycodec.Decode(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode transaction blob: %w", err)
+	}
+	return tx, nil
+}
+
+// InspectBlob decodes a raw transaction blob like DecodeBlob, classifies it by type and
+// signature, and computes the hash of a signed blob.
+//
+// Parameters:
+// - blob: The hex encoded transaction
+//
+// Returns the decoded blob, or an error if it cannot be decoded or hashed.
+func (b *Blockchain) InspectBlob(blob string) (*DecodedBlob, error) {
+	tx, err := b.DecodeBlob(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	txType, _ := tx["TransactionType"].(string)
+	txnSignature, _ := tx["TxnSignature"].(string)
+	_, multisigned := tx["Signers"]
+	decoded := &DecodedBlob{
+		Tx:          tx,
+		TxType:      transactions.TxType(txType),
+		Signed:      txnSignature != "" || multisigned,
+		Multisigned: multisigned,
+	}
+
+	if decoded.Signed {
+		decoded.Hash, err = hash.SignTxBlob(blob)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute transaction hash: %w", err)
+		}
+	}
+	return decoded, nil
+}
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPaymentBlob is testPaymentFlatTx encoded without a signature.
+const testPaymentBlob = "1200002200000000240000000A201B000000786140000000000003E868400000000000000C" +
+	"8114D0045E31BC8E02B4A85A5FE56B33AA25C37454058314F667B0CA50CC7709A220B0561B85E53A48461FA8"
+
+func TestBlockchain_DecodeBlob(t *testing.T) {
+	bc, _ := newTestBlockchain(t, nil)
+
+	tx, err := bc.DecodeBlob(testPaymentBlob)
+	assert.NoError(t, err)
+	assert.Equal(t, testPaymentFlatTx(), tx)
+
+	decoded, err := bc.InspectBlob(testPaymentBlob)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Payment", string(decoded.TxType))
+		assert.False(t, decoded.Signed)
+		assert.Empty(t, decoded.Hash)
+
+		out, err := decoded.JSON()
+		assert.NoError(t, err)
+		assert.Contains(t, out, `"Destination": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"`)
+	}
+}
+
+func TestBlockchain_InspectBlob_Signed(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	blob, expectedHash, err := bc.w.Sign(testPaymentFlatTx())
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+
+	decoded, err := bc.InspectBlob(blob)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedHash, decoded.Hash)
+		assert.True(t, decoded.Signed)
+		assert.False(t, decoded.Multisigned)
+		assert.Equal(t, bc.w.PublicKey, decoded.Tx["SigningPubKey"])
+	}
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_DecodeBlob_Malformed(t *testing.T) {
+	bc, _ := newTestBlockchain(t, nil)
+
+	for _, blob := range []string{"", "12000", "not hex"} {
+		_, err := bc.DecodeBlob(blob)
+		assert.True(t, errors.Is(err, ErrMalformedBlob), "blob %q: got %v", blob, err)
+	}
+
+	_, err := bc.InspectBlob("FFFF")
+	assert.Error(t, err)
+}
@@ -3,16 +3,16 @@
 package api
 
 import (
-	"encoding/hex"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strconv"
-	"strings"
 	"sync"
 	"time"
 
-	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
 	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
 	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
@@ -33,12 +33,22 @@ const (
 	xrpToDrops = 1000000
 )
 
+var (
+	// ErrLockTimeout is returned when the blockchain lock is not acquired before the context is done.
+	ErrLockTimeout = errors.New("timed out waiting for the blockchain lock")
+	// ErrAccountNotFound is returned when an account is not funded in the ledger.
+	ErrAccountNotFound = errors.New("account not found")
+	// ErrInvalidSequence is returned when a transaction is given a zero Sequence.
+	ErrInvalidSequence = errors.New("invalid sequence")
+)
+
 type SubmittableTransaction interface {
 	TxType() transactions.TxType
 	Flatten() transactions.FlatTransaction
 }
 
 type MPToken interface {
+	Validate() error
 	CreateMetadata() (MPTokenMetadata, error)
 }
 
@@ -46,50 +56,214 @@ type MPToken interface {
 // It provides methods for interacting with the XRPL network, including
 // account operations, transaction submission, and token management.
 type Blockchain struct {
-	mu sync.Mutex
-	c  *rpc.Client
-	w  *wallet.Wallet
+	// sem is the lock of Lock and TryLock, a channel so that acquiring it can be abandoned
+	semOnce sync.Once
+	sem     chan struct{}
+	c       *rpc.Client
+	// poll serves the reads of background polling, which SetContext never binds
+	poll *rpc.Client
+
+	// walletMu guards w; the lock is held across whole handler operations and cannot guard fields
+	walletMu sync.RWMutex
+	w        *wallet.Wallet
+
+	auditor         TxAuditor
+	metrics         Metrics
+	logger          *slog.Logger
+	txLogger        *slog.Logger
+	correlationID   string
+	correlationMemo bool
+
+	reqCtx       *requestContext
+	accounts     *accountInfoCache
+	wallets      *crypto.WalletCache
+	sequences    sequenceManager
+	held         heldSequences
+	txnIDs       accountTxnIDs
+	submitMode   SubmitMode
+	ledgerOffset uint32
+	allowedTypes map[transactions.TxType]struct{}
+
+	confirmationDepth uint32
+	maxBatchSize      int
+	wait              waitOptions
+
+	watchInterval time.Duration
 }
 
 // NewBlockchain creates and returns a new Blockchain instance.
-// It initializes the XRPL client connection and system wallet using the provided configuration.
+// It initializes the XRPL client connection and system wallet using the provided configuration,
+// which is validated first so that a misconfigured environment fails at startup.
 //
 // Parameters:
 // - cfg: Network configuration containing RPC URL, timeout, and system account details
 //
 // Returns a configured Blockchain instance or an error if initialization fails.
 func NewBlockchain(cfg config.NetworkConfig) (*Blockchain, error) {
-	rpcCfg, err := rpc.NewClientConfig(cfg.URL, rpc.WithHTTPClient(&http.Client{
-		Timeout: time.Duration(cfg.Timeout) * time.Second,
-	}))
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	reqCtx := &requestContext{}
+	httpClient := &contextHTTPClient{
+		HTTPClient: &http.Client{
+			Timeout: time.Duration(cfg.Timeout) * time.Second,
+		},
+		reqCtx: reqCtx,
+	}
+	opts := []rpc.ConfigOpt{
+		rpc.WithHTTPClient(httpClient),
+	}
+	if cfg.Faucet.URL != "" {
+		opts = append(opts, rpc.WithFaucetProvider(NewHTTPFaucet(cfg.Faucet.URL, httpClient)))
+	}
+	for key, value := range cfg.Headers {
+		opts = append(opts, WithHeader(key, value))
+	}
+	if cfg.AuthToken != "" {
+		opts = append(opts, WithBearerToken(cfg.AuthToken))
+	}
+
+	rpcCfg, err := rpc.NewClientConfig(cfg.URL, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create JSON-RPC config for %s: %w", cfg.URL, err)
 	}
 	client := rpc.NewClient(rpcCfg)
+	pollCfg := *rpcCfg
+	pollCfg.HTTPClient = httpClient.HTTPClient
+
+	if cfg.DetectNetworkID {
+		if err := detectNetworkID(client); err != nil {
+			return nil, err
+		}
+	}
 
 	w, err := crypto.NewWallet(types.Address(cfg.System.Account), cfg.System.Public, cfg.System.Secret)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create wallet: %w", err)
 	}
 
-	return &Blockchain{
-		c: client,
-		w: w,
-	}, nil
+	bc := &Blockchain{
+		c:       client,
+		poll:    rpc.NewClient(&pollCfg),
+		w:       w,
+		reqCtx:  reqCtx,
+		metrics: NopMetrics{},
+	}
+	if cfg.CheckAmendments {
+		if err := bc.CheckRequiredAmendments(); err != nil {
+			return nil, err
+		}
+	}
+	bc.EnableAccountInfoCache(time.Duration(cfg.AccountCacheTTL) * time.Second)
+	bc.EnableWalletCache(cfg.WalletCacheSize)
+
+	mode, err := ParseSubmitMode(cfg.SubmitMode)
+	if err != nil {
+		return nil, err
+	}
+	bc.SetSubmitMode(mode)
+	bc.SetLedgerOffset(cfg.LedgerOffset)
+	bc.SetConfirmationDepth(cfg.ConfirmationDepth)
+	if err := bc.SetMaxBatchSize(cfg.MaxBatchSize); err != nil {
+		return nil, err
+	}
+	bc.EnableCorrelationMemo(cfg.CorrelationMemo)
+	allowed := make([]transactions.TxType, len(cfg.AllowedTxTypes))
+	for i, name := range cfg.AllowedTxTypes {
+		allowed[i] = transactions.TxType(name)
+	}
+	bc.SetAllowedTxTypes(allowed...)
+
+	return bc, nil
+}
+
+// detectNetworkID reads the network ID from the node and sets it on the client
+// when the network requires transactions to carry it.
+func detectNetworkID(client *rpc.Client) error {
+	info, err := client.GetServerInfo(&server.InfoRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get server info: %w", err)
+	}
+
+	if info.Info.NetworkID > rpc.RestrictedNetworks {
+		client.NetworkID = uint32(info.Info.NetworkID)
+	}
+
+	return nil
 }
 
 // Lock acquires an exclusive lock on the blockchain instance.
-// This method should be called before performing any operations that require
-// exclusive access to the blockchain state.
+// It serializes the submissions of a request, such as a transfer and the payments it
+// depends on, and scopes the request context and correlation ID to that request.
+// It does not guard field access: the system wallet is read through SystemWallet,
+// which is safe with or without the lock held.
+// Request handlers use TryLock, which gives up with the request.
 func (b *Blockchain) Lock() {
-	b.mu.Lock()
+	b.semaphore() <- struct{}{}
+}
+
+// TryLock acquires the lock like Lock, but gives up once the context is done, so that
+// requests fail fast while another request holds the lock instead of piling up behind it.
+//
+// Parameters:
+// - ctx: The context bounding the wait, usually the request context
+//
+// Returns nil once the lock is held, or an error wrapping ErrLockTimeout and the context error.
+func (b *Blockchain) TryLock(ctx context.Context) error {
+	// A free lock is taken even with a done context, select would pick either at random
+	select {
+	case b.semaphore() <- struct{}{}:
+		return nil
+	default:
+	}
+
+	select {
+	case b.semaphore() <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %w", ErrLockTimeout, ctx.Err())
+	}
+}
+
+// semaphore returns the channel of the lock, creating it on first use.
+func (b *Blockchain) semaphore() chan struct{} {
+	b.semOnce.Do(func() { b.sem = make(chan struct{}, 1) })
+	return b.sem
 }
 
-// Unlock releases the exclusive lock on the blockchain instance.
-// This method should be called after completing operations that required
-// exclusive access to the blockchain state.
+// Unlock releases the exclusive lock on the blockchain instance
+// and clears the request context and correlation ID set under it.
+// Like sync.Mutex, it panics if the lock is not held.
 func (b *Blockchain) Unlock() {
-	b.mu.Unlock()
+	b.correlationID = ""
+	b.reqCtx.set(nil)
+	select {
+	case <-b.semaphore():
+	default:
+		panic("api: unlock of unlocked Blockchain")
+	}
+}
+
+// SystemWallet returns the wallet of the system account, which issues RLUSD
+// and funds new accounts.
+//
+// Returns the system wallet.
+func (b *Blockchain) SystemWallet() *wallet.Wallet {
+	b.walletMu.RLock()
+	defer b.walletMu.RUnlock()
+	return b.w
+}
+
+// SetSystemWallet replaces the wallet of the system account, such as after
+// rotating its regular key. Submissions already in flight keep the wallet they read.
+//
+// Parameters:
+// - w: The new system wallet
+func (b *Blockchain) SetSystemWallet(w *wallet.Wallet) {
+	b.walletMu.Lock()
+	defer b.walletMu.Unlock()
+	b.w = w
 }
 
 // GetBaseFeeAndReserve retrieves the current base fee and reserve requirements from the XRPL network.
@@ -125,139 +299,277 @@ func (b *Blockchain) GetMPTokenCount(address string) (count int, err error) {
 // Parameters:
 // - w: The wallet used to sign the transaction
 // - tx: The transaction to submit
+// - opts: Options of this submission, such as WithNetworkID or WithAccountTxnID
 //
-// Returns the submit response, XRPL response, and any error that occurred during submission.
-func (b *Blockchain) SubmitTx(w *wallet.Wallet, tx SubmittableTransaction) (
+// Returns the transaction hash, or an error if the submission fails.
+func (b *Blockchain) SubmitTx(w *wallet.Wallet, tx SubmittableTransaction, opts ...SubmitOption) (
 	hash string, err error) {
+	resp, err := b.SubmitTxDetailed(w, tx, opts...)
+	if err != nil {
+		return "", err
+	}
+	return resp.Tx["hash"].(string), nil
+}
+
+// SubmitTxDetailed submits a transaction like SubmitTx and returns the whole submit response,
+// including the engine result code and message, for callers that need them for diagnostics.
+//
+// Parameters:
+// - w: The wallet used to sign the transaction
+// - tx: The transaction to submit
+// - opts: Options of this submission, such as WithNetworkID
+//
+// Returns the submit response, or an error if the submission fails. When the node rejects
+// the transaction, the response is returned along with an error wrapping an EngineError.
+func (b *Blockchain) SubmitTxDetailed(w *wallet.Wallet, tx SubmittableTransaction, opts ...SubmitOption) (
+	resp *requests.SubmitResponse, err error) {
 	if w == nil {
-		return "", fmt.Errorf("wallet cannot be nil")
+		return nil, fmt.Errorf("wallet cannot be nil")
 	}
 	if tx == nil {
-		return "", fmt.Errorf("transaction cannot be nil")
+		return nil, fmt.Errorf("transaction cannot be nil")
+	}
+	if err := b.checkTxTypeAllowed(tx.TxType()); err != nil {
+		return nil, err
+	}
+	var fee uint64
+	defer func(start time.Time) { b.observeSubmit(tx.TxType(), start, fee, err) }(time.Now())
+	options, err := newSubmitOptions(opts)
+	if err != nil {
+		return nil, err
 	}
 
-	// Access BaseTx fields directly since all transaction types embed BaseTx
-	flattenedTx := tx.Flatten()
-	flattenedTx["Account"] = w.ClassicAddress.String()
-	flattenedTx["SigningPubKey"] = w.PublicKey
+	defer b.accounts.invalidate(w.ClassicAddress.String())
+	flattenedTx, release, err := b.prepareTx(w, tx, options)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := b.c.SubmitTx(flattenedTx, &rpctypes.SubmitOptions{
+	resp, err = b.c.SubmitTx(flattenedTx, &rpctypes.SubmitOptions{
 		Autofill: true,
-		FailHard: false,
+		FailHard: options.failHard,
 		Wallet:   w,
 	})
+	applied := err == nil && resp.EngineResult == string(transactions.TesSUCCESS)
+	if applied {
+		hash, _ := resp.Tx["hash"].(string)
+		b.txnIDs.record(w.ClassicAddress.String(), hash, options.accountTxnID)
+	} else {
+		b.txnIDs.forget(w.ClassicAddress.String())
+	}
+	release(applied)
 	if err != nil {
-		return "", fmt.Errorf("failed to submit tx: %w", err)
+		return nil, fmt.Errorf("failed to submit tx: %w", err)
 	}
 
+	fee = feeDrops(resp.Tx)
 	if resp.EngineResult != string(transactions.TesSUCCESS) {
-		return "", &rpc.ClientError{ErrorString: "transaction failed to submit with engine result: " + resp.EngineResult}
+		return resp, fmt.Errorf("failed to submit tx: %w", &EngineError{
+			Result:        transactions.TxResult(resp.EngineResult),
+			ResultMessage: resp.EngineResultMessage,
+		})
 	}
 
-	hash = resp.Tx["hash"].(string)
-	if hash == "" {
-		return "", fmt.Errorf("hash is empty")
+	if hash, _ := resp.Tx["hash"].(string); hash == "" {
+		return nil, fmt.Errorf("hash is empty")
 	}
+	b.audit(tx.TxType(), w.ClassicAddress.String(), resp.Tx)
 
-	return hash, nil
+	return resp, nil
+}
+
+// prepareTx flattens a transaction signed by the wallet and completes it for submission:
+// the submit options, the correlation memo, resolved X-addresses, LastLedgerSequence, the
+// reserved Sequence and, if requested, AccountTxnID. The transaction is logged once complete.
+//
+// Returns the transaction and the release of its sequence, which the caller must call
+// with whether the submission consumed it.
+func (b *Blockchain) prepareTx(w *wallet.Wallet, tx SubmittableTransaction, options submitOptions) (
+	transactions.FlatTransaction, func(consumed bool), error) {
+	flattenedTx := prepareFlatTransaction(w, tx)
+	options.applyTo(flattenedTx)
+	b.addCorrelationMemo(flattenedTx)
+	if err := resolveXAddresses(flattenedTx); err != nil {
+		return nil, nil, fmt.Errorf("invalid transaction: %w", err)
+	}
+	if err := b.setLastLedgerSequence(flattenedTx); err != nil {
+		return nil, nil, err
+	}
+	release, err := b.reserveSequence(flattenedTx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if options.accountTxnID {
+		if err := b.setAccountTxnID(flattenedTx); err != nil {
+			release(false)
+			return nil, nil, err
+		}
+	}
+	if err := b.logTransaction(w, flattenedTx); err != nil {
+		release(false)
+		return nil, nil, err
+	}
+	return flattenedTx, release, nil
 }
 
 // SubmitTxWithSequence submits a transaction to the XRPL network and returns the hash and sequence.
-func (b *Blockchain) SubmitTxWithSequence(w *wallet.Wallet, tx SubmittableTransaction) (
+func (b *Blockchain) SubmitTxWithSequence(w *wallet.Wallet, tx SubmittableTransaction, opts ...SubmitOption) (
 	hash string, sequence uint32, err error) {
-	if w == nil {
-		return "", 0, fmt.Errorf("wallet cannot be nil")
+	resp, err := b.SubmitTxDetailed(w, tx, opts...)
+	if err != nil {
+		return "", 0, err
 	}
-	if tx == nil {
-		return "", 0, fmt.Errorf("transaction cannot be nil")
+
+	sequence, err = uint32Field(resp.Tx, "Sequence", true)
+	if err != nil {
+		return "", 0, err
 	}
 
-	// Access BaseTx fields directly since all transaction types embed BaseTx
-	flattenedTx := tx.Flatten()
-	flattenedTx["Account"] = w.ClassicAddress.String()
-	flattenedTx["SigningPubKey"] = w.PublicKey
+	return resp.Tx["hash"].(string), sequence, nil
+}
 
-	resp, err := b.c.SubmitTx(flattenedTx, &rpctypes.SubmitOptions{
-		Autofill: true,
-		FailHard: false,
-		Wallet:   w,
-	})
-	if err != nil {
-		return "", 0, fmt.Errorf("failed to submit tx: %w", err)
+// SubmitTxWithExplicitSequence submits a transaction with the given Sequence, used verbatim,
+// so that several transactions of an account can be queued with consecutive sequences
+// assigned up front. The fee and LastLedgerSequence are still autofilled. The sequence
+// manager does not hand out the sequence, and reads the account's next sequence from the
+// node again afterwards.
+//
+// Parameters:
+// - w: The wallet used to sign the transaction
+// - tx: The transaction to submit
+// - sequence: The Sequence of the transaction, not zero
+// - opts: Options of this submission, such as WithSourceTag
+//
+// Returns the transaction hash, an error wrapping ErrInvalidSequence for a zero sequence,
+// or an error if the submission fails.
+func (b *Blockchain) SubmitTxWithExplicitSequence(w *wallet.Wallet, tx SubmittableTransaction, sequence uint32,
+	opts ...SubmitOption) (hash string, err error) {
+	if sequence == 0 {
+		return "", fmt.Errorf("%w: sequence must not be zero", ErrInvalidSequence)
+	}
+	if w != nil {
+		defer b.sequences.forget(w.ClassicAddress.String())
 	}
 
-	if resp.EngineResult != string(transactions.TesSUCCESS) {
-		return "", 0, &rpc.ClientError{ErrorString: "transaction failed to submit with engine result: " + resp.EngineResult}
+	opts = append(opts[:len(opts):len(opts)], func(o *submitOptions) { o.sequence = &sequence })
+	return b.SubmitTx(w, tx, opts...)
+}
+
+// submitForSequence submits a transaction in the configured submit mode and returns its hash and
+// sequence, which identifies objects created by the transaction such as checks or escrows.
+func (b *Blockchain) submitForSequence(w *wallet.Wallet, tx SubmittableTransaction) (
+	hash string, sequence uint32, err error) {
+	if b.submitMode != WaitForValidation {
+		return b.SubmitTxWithSequence(w, tx)
 	}
 
-	hash = resp.Tx["hash"].(string)
-	if hash == "" {
-		return "", 0, fmt.Errorf("hash is empty")
+	resp, err := b.submitAndWait(w, tx)
+	if err != nil {
+		return "", 0, err
+	}
+	sequence, err = uint32Field(resp.TxJson, "Sequence", true)
+	if err != nil {
+		return "", 0, err
 	}
+	return string(resp.Hash), sequence, nil
+}
 
-	// Get sequence from the response
-	sequenceValue, ok := resp.Tx["Sequence"]
-	if !ok {
-		return "", 0, fmt.Errorf("sequence not found in response")
-	}
-
-	// Handle different numeric types that might be returned
-	switch v := sequenceValue.(type) {
-	case uint32:
-		sequence = v
-	case int:
-		sequence = uint32(v)
-	case float64:
-		sequence = uint32(v)
-	case int64:
-		sequence = uint32(v)
-	case json.Number:
-		// Handle json.Number type
-		intVal, err := v.Int64()
-		if err != nil {
-			return "", 0, fmt.Errorf("failed to convert sequence to int64: %w", err)
-		}
-		sequence = uint32(intVal)
-	default:
-		return "", 0, fmt.Errorf("sequence has unexpected type: %T", v)
+// SubmitTxAndWait submits a transaction and waits until it is validated.
+//
+// Parameters:
+// - w: The wallet used to sign the transaction
+// - tx: The transaction to submit
+//
+// Returns the transaction hash, or an error if submission fails or the transaction is not validated successfully.
+func (b *Blockchain) SubmitTxAndWait(w *wallet.Wallet, tx SubmittableTransaction, opts ...SubmitOption) (txHash string, err error) {
+	resp, err := b.submitAndWait(w, tx, opts...)
+	if err != nil {
+		return "", err
 	}
 
-	return hash, sequence, nil
+	return string(resp.Hash), nil
 }
 
-func (b *Blockchain) SubmitTxAndWait(w *wallet.Wallet, tx SubmittableTransaction) error {
+// submitAndWait submits a transaction, with LastLedgerSequence set by the ledger offset or
+// the client's autofill, polls until it is validated, and checks the outcome.
+func (b *Blockchain) submitAndWait(w *wallet.Wallet, tx SubmittableTransaction, opts ...SubmitOption) (
+	resp *requests.TxResponse, err error) {
 	if w == nil {
-		return fmt.Errorf("wallet cannot be nil")
+		return nil, fmt.Errorf("wallet cannot be nil")
 	}
 	if tx == nil {
-		return fmt.Errorf("transaction cannot be nil")
+		return nil, fmt.Errorf("transaction cannot be nil")
+	}
+	if err := b.checkTxTypeAllowed(tx.TxType()); err != nil {
+		return nil, err
+	}
+	var fee uint64
+	defer func(start time.Time) { b.observeSubmit(tx.TxType(), start, fee, err) }(time.Now())
+	options, err := newSubmitOptions(opts)
+	if err != nil {
+		return nil, err
 	}
 
-	// Access BaseTx fields directly since all transaction types embed BaseTx
-	flattenedTx := tx.Flatten()
-	flattenedTx["Account"] = w.ClassicAddress.String()
-	flattenedTx["SigningPubKey"] = w.PublicKey
+	defer b.accounts.invalidate(w.ClassicAddress.String())
+	flattenedTx, release, err := b.prepareTx(w, tx, options)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := b.c.SubmitTxAndWait(flattenedTx, &rpctypes.SubmitOptions{
+	sub, err := b.c.SubmitTx(flattenedTx, &rpctypes.SubmitOptions{
 		Autofill: true,
-		FailHard: false,
+		FailHard: options.failHard,
 		Wallet:   w,
 	})
+	// The sequence is committed as soon as the node accepts the transaction, so the next
+	// submission of the account does not wait for this one to be validated
+	release(err == nil && sequenceConsumed(sub.EngineResult))
+	if err == nil && sub.EngineResult != string(transactions.TesSUCCESS) {
+		err = &EngineError{
+			Result:        transactions.TxResult(sub.EngineResult),
+			ResultMessage: sub.EngineResultMessage,
+		}
+	}
+	if err == nil {
+		b.audit(tx.TxType(), w.ClassicAddress.String(), sub.Tx)
+		resp, err = b.awaitValidation(sub.Tx)
+	}
+	// A validated transaction is the last of the account whatever its result
+	if err == nil && resp.Validated {
+		b.txnIDs.record(w.ClassicAddress.String(), string(resp.Hash), options.accountTxnID)
+	} else {
+		b.txnIDs.forget(w.ClassicAddress.String())
+	}
 	if err != nil {
-		return fmt.Errorf("failed to submit tx: %w", err)
+		return nil, fmt.Errorf("failed to submit tx: %w", err)
 	}
+	fee = feeDrops(resp.TxJson)
 
-	return nil
+	if err := transactionFailure(resp); err != nil {
+		return nil, err
+	}
+	if b.depth() > DefaultConfirmationDepth {
+		if err := b.AwaitConfirmationDepth(b.boundContext(), resp.LedgerIndex.Uint32()); err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", resp.Hash, err)
+		}
+	}
+
+	return resp, nil
 }
 
 // GetAccountInfo retrieves detailed information about an XRPL account.
 // This includes the account's balance, sequence number, and other account-specific data.
+// The response is served from the account info cache when it is enabled.
 //
 // Parameters:
 // - address: The XRPL account address to query
 //
 // Returns account information or an error if the request fails.
 func (b *Blockchain) GetAccountInfo(address string) (*account.InfoResponse, error) {
+	if info, ok := b.accounts.get(address); ok {
+		return info, nil
+	}
+
 	accountInfoReq := &account.InfoRequest{
 		Account:     types.Address(address),
 		LedgerIndex: common.Validated,
@@ -266,9 +578,28 @@ func (b *Blockchain) GetAccountInfo(address string) (*account.InfoResponse, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to get account info: %w", err)
 	}
+	b.accounts.set(address, accountInfo)
 	return accountInfo, nil
 }
 
+// GetXrpBalance retrieves the XRP balance of an account in the validated ledger.
+//
+// Parameters:
+// - address: The XRPL account address to query
+//
+// Returns the balance in drops, an error wrapping ErrAccountNotFound if the account
+// is not funded, or an error if the request fails.
+func (b *Blockchain) GetXrpBalance(address string) (uint64, error) {
+	info, err := b.GetAccountInfo(address)
+	if err != nil {
+		if isRPCError(err, "actNotFound") {
+			return 0, fmt.Errorf("%w: %s", ErrAccountNotFound, address)
+		}
+		return 0, err
+	}
+	return uint64(info.AccountData.Balance), nil
+}
+
 // GetTransactionInfo retrieves detailed information about a specific transaction.
 // This includes transaction metadata, base transaction details, and validation status.
 //
@@ -276,12 +607,14 @@ func (b *Blockchain) GetAccountInfo(address string) (*account.InfoResponse, erro
 // - hash: The transaction hash to query
 //
 // Returns transaction response, metadata, base transaction, and any error that occurred.
+// The metadata DeliveredAmount field holds the delivered amount as a types.CurrencyAmount, if any.
+// The lookup is served by the polling client, so the context of the lock holder does not abort it.
 func (b *Blockchain) GetTransactionInfo(hash string) (
 	resp *requests.TxResponse,
 	meta transactions.TxObjMeta,
 	baseTx *transactions.BaseTx,
 	err error) {
-	res, err := b.c.Request(&requests.TxRequest{
+	res, err := b.pollClient().Request(&requests.TxRequest{
 		Transaction: hash,
 	})
 	if err != nil {
@@ -324,101 +657,34 @@ func (b *Blockchain) GetTransactionInfo(hash string) (
 		}
 	}
 
+	// Normalize the delivered amount so callers get a typed value for partial payments
+	delivered, err := DeliveredAmount(meta)
+	if err != nil {
+		return nil, transactions.TxObjMeta{}, nil, err
+	}
+	meta.DeliveredAmount = delivered
+
 	// Safely extract fields from transaction with type assertions
 	account, ok := txResp.TxJson["Account"].(string)
 	if !ok {
 		return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to extract Account from transaction")
 	}
 
-	// Try different types for Fee
-	var fee float64
-	if feeFloat, ok := txResp.TxJson["Fee"].(float64); ok {
-		fee = feeFloat
-	} else if feeString, ok := txResp.TxJson["Fee"].(string); ok {
-		// Try to parse string to float64
-		if parsedFee, err := strconv.ParseFloat(feeString, 64); err == nil {
-			fee = parsedFee
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse Fee string '%s': %w", feeString, err)
-		}
-	} else if feeNumber, ok := txResp.TxJson["Fee"].(json.Number); ok {
-		// Try to parse json.Number to float64
-		if parsedFee, err := feeNumber.Float64(); err == nil {
-			fee = parsedFee
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse Fee json.Number '%s': %w", feeNumber, err)
-		}
-	} else {
-		return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to extract Fee from transaction, got type: %T", txResp.Tx["Fee"])
-	}
-
-	// Try different types for Flags
-	var flags float64
-	if txResp.TxJson["Flags"] == nil {
-		// Flags can be nil if not set
-		flags = 0
-	} else if flagsFloat, ok := txResp.TxJson["Flags"].(float64); ok {
-		flags = flagsFloat
-	} else if flagsString, ok := txResp.TxJson["Flags"].(string); ok {
-		if parsedFlags, err := strconv.ParseFloat(flagsString, 64); err == nil {
-			flags = parsedFlags
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse Flags string '%s': %w", flagsString, err)
-		}
-	} else if flagsNumber, ok := txResp.TxJson["Flags"].(json.Number); ok {
-		if parsedFlags, err := flagsNumber.Float64(); err == nil {
-			flags = parsedFlags
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse Flags json.Number '%s': %w", flagsNumber, err)
-		}
-	} else {
-		return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to extract Flags from transaction, got type: %T", txResp.Tx["Flags"])
-	}
-
-	// Try different types for LastLedgerSequence
-	var lastLedgerSeq float64
-	if txResp.TxJson["LastLedgerSequence"] == nil {
-		// LastLedgerSequence can be nil if not set
-		lastLedgerSeq = 0
-	} else if lastLedgerSeqFloat, ok := txResp.TxJson["LastLedgerSequence"].(float64); ok {
-		lastLedgerSeq = lastLedgerSeqFloat
-	} else if lastLedgerSeqString, ok := txResp.TxJson["LastLedgerSequence"].(string); ok {
-		if parsedLastLedgerSeq, err := strconv.ParseFloat(lastLedgerSeqString, 64); err == nil {
-			lastLedgerSeq = parsedLastLedgerSeq
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse LastLedgerSequence string '%s': %w", lastLedgerSeqString, err)
-		}
-	} else if lastLedgerSeqNumber, ok := txResp.TxJson["LastLedgerSequence"].(json.Number); ok {
-		if parsedLastLedgerSeq, err := lastLedgerSeqNumber.Float64(); err == nil {
-			lastLedgerSeq = parsedLastLedgerSeq
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse LastLedgerSequence json.Number '%s': %w", lastLedgerSeqNumber, err)
-		}
-	} else {
-		return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to extract LastLedgerSequence from transaction, got type: %T", txResp.Tx["LastLedgerSequence"])
-	}
-
-	// Try different types for Sequence
-	var sequence float64
-	if txResp.TxJson["Sequence"] == nil {
-		// Sequence should not be nil, but handle it gracefully
-		return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("sequence is required but was nil")
-	} else if sequenceFloat, ok := txResp.TxJson["Sequence"].(float64); ok {
-		sequence = sequenceFloat
-	} else if sequenceString, ok := txResp.TxJson["Sequence"].(string); ok {
-		if parsedSequence, err := strconv.ParseFloat(sequenceString, 64); err == nil {
-			sequence = parsedSequence
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse Sequence string '%s': %w", sequenceString, err)
-		}
-	} else if sequenceNumber, ok := txResp.TxJson["Sequence"].(json.Number); ok {
-		if parsedSequence, err := sequenceNumber.Float64(); err == nil {
-			sequence = parsedSequence
-		} else {
-			return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse Sequence json.Number '%s': %w", sequenceNumber, err)
-		}
-	} else {
-		return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to extract Sequence from transaction, got type: %T", txResp.Tx["Sequence"])
+	fee, err := FeeFromAny(txResp.TxJson["Fee"])
+	if err != nil {
+		return nil, transactions.TxObjMeta{}, nil, fmt.Errorf("failed to parse Fee: %w", err)
+	}
+	flags, err := uint32Field(txResp.TxJson, "Flags", false)
+	if err != nil {
+		return nil, transactions.TxObjMeta{}, nil, err
+	}
+	lastLedgerSeq, err := uint32Field(txResp.TxJson, "LastLedgerSequence", false)
+	if err != nil {
+		return nil, transactions.TxObjMeta{}, nil, err
+	}
+	sequence, err := uint32Field(txResp.TxJson, "Sequence", true)
+	if err != nil {
+		return nil, transactions.TxObjMeta{}, nil, err
 	}
 
 	signingPubKey, ok := txResp.TxJson["SigningPubKey"].(string)
@@ -438,10 +704,10 @@ func (b *Blockchain) GetTransactionInfo(hash string) (
 
 	baseTx = &transactions.BaseTx{
 		Account:            types.Address(account),
-		Fee:                types.XRPCurrencyAmount(uint64(fee)),
-		Flags:              uint32(flags),
-		LastLedgerSequence: uint32(lastLedgerSeq),
-		Sequence:           uint32(sequence),
+		Fee:                types.XRPCurrencyAmount(fee),
+		Flags:              flags,
+		LastLedgerSequence: lastLedgerSeq,
+		Sequence:           sequence,
 		SigningPubKey:      signingPubKey,
 		TransactionType:    transactions.TxType(transactionType),
 		TxnSignature:       txnSignature,
@@ -459,7 +725,7 @@ func (b *Blockchain) GetTransactionInfo(hash string) (
 //
 // Returns the transaction hash if successful, or an error if the transfer fails.
 func (b *Blockchain) PaymentXRPFromSystemAccount(to string, amount uint64) (hash string, err error) {
-	return b.PaymentXRP(b.w, types.Address(to), amount)
+	return b.PaymentXRP(b.SystemWallet(), types.Address(to), amount)
 }
 
 // PaymentToSystemAccount transfers XRP from the specified source wallet to the system account.
@@ -471,7 +737,7 @@ func (b *Blockchain) PaymentXRPFromSystemAccount(to string, amount uint64) (hash
 //
 // Returns the transaction hash if successful, or an error if the transfer fails.
 func (b *Blockchain) PaymentXRPToSystemAccount(from *wallet.Wallet, amount uint64) (hash string, err error) {
-	return b.PaymentXRP(from, b.w.ClassicAddress, amount)
+	return b.PaymentXRP(from, b.SystemWallet().ClassicAddress, amount)
 }
 
 // Payment executes a payment transaction between two accounts.
@@ -481,15 +747,17 @@ func (b *Blockchain) PaymentXRPToSystemAccount(from *wallet.Wallet, amount uint6
 // - from: The source wallet
 // - to: The destination account address
 // - amount: The amount to transfer in drops
+// - opts: Options of the submission, such as WithSourceTag
 //
 // Returns the transaction hash if successful, or an error if the payment fails.
-func (b *Blockchain) PaymentXRP(from *wallet.Wallet, to types.Address, amount uint64) (txHash string, err error) {
+func (b *Blockchain) PaymentXRP(from *wallet.Wallet, to types.Address, amount uint64, opts ...SubmitOption) (
+	txHash string, err error) {
 	payment := &transactions.Payment{
 		Amount:      types.XRPCurrencyAmount(amount),
 		Destination: to,
 	}
 
-	return b.SubmitTx(from, payment)
+	return b.SubmitTx(from, payment, opts...)
 }
 
 // MPTokenIssuanceCreate creates a new Multi-Purpose Token (MPT) on the XRPL network.
@@ -498,9 +766,20 @@ func (b *Blockchain) PaymentXRP(from *wallet.Wallet, to types.Address, amount ui
 // Parameters:
 // - issuer: The wallet that will own the token
 // - mpt: The MPToken containing document hash and signature information
+// - opts: Options such as WithMPTFlags, the issuance gets DefaultMPTFlags otherwise
 //
-// Returns the transaction hash and issuance ID if successful, or an error if creation fails.
-func (b *Blockchain) MPTokenIssuanceCreate(issuer *wallet.Wallet, mpt MPToken) (txHash, issuanceID string, err error) {
+// Returns the transaction hash and issuance ID if successful, or an error if the token
+// fails validation or creation fails.
+func (b *Blockchain) MPTokenIssuanceCreate(issuer *wallet.Wallet, mpt MPToken, opts ...IssuanceOption) (
+	txHash, issuanceID string, err error) {
+	o := issuanceOptions{flags: DefaultMPTFlags()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if err := mpt.Validate(); err != nil {
+		return "", "", err
+	}
+
 	md, err := mpt.CreateMetadata()
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create metadata: %w", err)
@@ -517,78 +796,161 @@ func (b *Blockchain) MPTokenIssuanceCreate(issuer *wallet.Wallet, mpt MPToken) (
 		MaximumAmount:   &maxAmount,
 		TransferFee:     types.TransferFee(0),
 	}
-	tx.SetMPTCanEscrowFlag()
-	tx.SetMPTCanTradeFlag()
-	tx.SetMPTCanTransferFlag()
+	o.flags.applyTo(tx)
 
-	hash, sequence, err := b.SubmitTxWithSequence(issuer, tx)
+	if b.submitMode == WaitForValidation {
+		resp, err := b.submitAndWait(issuer, tx)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to submit tx: %w", err)
+		}
+
+		sequence, err := uint32Field(resp.TxJson, "Sequence", true)
+		if err != nil {
+			return "", "", err
+		}
+
+		issuanceID, err = CreateIssuanceID(string(issuer.ClassicAddress), sequence)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to create issuance id: %w", err)
+		}
+		return string(resp.Hash), issuanceID, nil
+	}
+
+	resp, err := b.SubmitTxDetailed(issuer, tx)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to submit tx: %w", err)
 	}
+	hash, _ := resp.Tx["hash"].(string)
+
+	sequence, err := uint32Field(resp.Tx, "Sequence", true)
+	if err != nil {
+		return "", "", err
+	}
 
 	issuanceID, err = CreateIssuanceID(string(issuer.ClassicAddress), sequence)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create issuance id: %w", err)
 	}
 
-	var meta transactions.TxObjMeta
-	for i := 0; i < 16; i++ {
-		time.Sleep(4 * time.Second)
-		_, meta, _, err = b.GetTransactionInfo(hash)
-		if err != nil {
-			continue
-		}
-		if strings.Contains(meta.TransactionResult, "SUCCESS") {
-			return hash, issuanceID, nil
-		}
+	// The issuance cannot be authorized before it is validated, so its validation is
+	// awaited even without waiting for other submissions
+	validated, err := b.awaitValidation(resp.Tx)
+	if err == nil {
+		err = transactionFailure(validated)
 	}
-
-	return hash, issuanceID, fmt.Errorf("transaction failed to confirm: %s, error: %w", meta.TransactionResult, err)
+	if err != nil {
+		return hash, issuanceID, fmt.Errorf("transaction failed to confirm: %w", err)
+	}
+	return hash, issuanceID, nil
 }
 
+// MPTokenIssuanceDestroy destroys an MPT issuance, freeing the issuer's reserve.
+// The node only destroys an issuance nobody holds, so outstanding supply is checked
+// first to report why instead of a bare ledger error.
+//
+// Parameters:
+// - holder: The issuer's wallet
+// - issuanceId: The ID of the token issuance to destroy
+//
+// Returns nil once the destruction is validated, an error wrapping ErrIssuanceHasHolders
+// if some of the token is still held, or an error if the destruction fails.
 func (b *Blockchain) MPTokenIssuanceDestroy(holder *wallet.Wallet, issuanceId string) error {
+	if err := b.checkIssuanceUnheld(issuanceId); err != nil {
+		return err
+	}
+
 	tx := &transactions.MPTokenIssuanceDestroy{
 		MPTokenIssuanceID: issuanceId,
 	}
 
-	return b.SubmitTxAndWait(holder, tx)
+	_, err := b.SubmitTxAndWait(holder, tx)
+	return err
 }
 
 // AuthorizeMPToken authorizes an MPT for use by the specified wallet.
 // This is required before the token can be transferred or used in transactions.
+// Authorizing an already authorized token is idempotent: the node's tecDUPLICATE is treated as success.
 //
 // Parameters:
 // - w: The wallet to authorize the token for
 // - issuanceId: The ID of the token issuance to authorize
 //
-// Returns the transaction hash if successful, or an error if authorization fails.
+// Returns nil if the wallet is authorized, or an error wrapping ErrMPTokenAuthorization
+// (and the EngineError, if the node rejected it) if authorization fails.
 func (b *Blockchain) AuthorizeMPToken(w *wallet.Wallet, issuanceId string) error {
+	_, err := b.AuthorizeMPTokenWithHash(w, issuanceId)
+	return err
+}
+
+// AuthorizeMPTokenWithHash authorizes an MPT like AuthorizeMPToken and returns the hash
+// of the authorization, for callers that record it.
+//
+// Returns the transaction hash, empty if the wallet was already authorized,
+// or an error wrapping ErrMPTokenAuthorization if authorization fails.
+func (b *Blockchain) AuthorizeMPTokenWithHash(w *wallet.Wallet, issuanceId string) (txHash string, err error) {
 	tx := &transactions.MPTokenAuthorize{
 		MPTokenIssuanceID: issuanceId,
 	}
 
-	return b.SubmitTxAndWait(w, tx)
+	txHash, err = b.SubmitTxAndWait(w, tx)
+	if errors.Is(err, &EngineError{Result: transactions.TecDUPLICATE}) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w %s: %w", ErrMPTokenAuthorization, issuanceId, err)
+	}
+	return txHash, nil
 }
 
 // TransferMPToken transfers an MPT from one account to another.
 // The sender must be authorized to use the token before the transfer can succeed.
+// A single unit is sent unless WithAmount sets another amount, which must be a positive
+// integer within the issuance's maximum amount. Unless skipped with WithoutBalanceCheck,
+// a holder sending more than it holds is rejected with ErrInsufficientMPTBalance before
+// submission. With WithValidation it waits for the transfer to be validated, and
+// WithSubmitOptions tags or otherwise adjusts the submitted payment, such as with WithSourceTag.
 //
 // Parameters:
 // - w: The sender's wallet
 // - issuanceId: The ID of the token issuance to transfer
 // - to: The destination account address
+// - opts: Options of the transfer
 //
-// Returns the transaction hash if successful, or an error if the transfer fails.
-func (b *Blockchain) TransferMPToken(w *wallet.Wallet, issuanceId, to string) (txHash string, err error) {
+// Returns the transaction hash if successful, an error wrapping ErrInvalidAmount for a malformed
+// amount or ErrMPTAmountExceedsMaximum for one over the maximum, or an error if the transfer fails.
+func (b *Blockchain) TransferMPToken(w *wallet.Wallet, issuanceId, to string, opts ...TransferOption) (txHash string, err error) {
+	var o transferOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var amount uint64 = 1
+	if o.amount != "" {
+		if amount, err = parseMPTValue(o.amount); err != nil {
+			return "", err
+		}
+		if err := b.checkMPTMaximum(w.ClassicAddress.String(), issuanceId, amount); err != nil {
+			return "", err
+		}
+	}
+	if !o.skipBalanceCheck {
+		if err := b.checkMPTokenBalance(w.ClassicAddress.String(), issuanceId, amount); err != nil {
+			return "", err
+		}
+	}
+
 	tx := &transactions.Payment{
 		Amount: types.MPTCurrencyAmount{
-			Value:         "1",
+			Value:         strconv.FormatUint(amount, 10),
 			MPTIssuanceID: issuanceId,
 		},
 		Destination: types.Address(to),
 	}
 
-	return b.SubmitTx(w, tx)
+	if o.waitForValidation {
+		return b.SubmitTxAndWait(w, tx, o.submitOpts...)
+	}
+	return b.SubmitTx(w, tx, o.submitOpts...)
 }
 
 // GetIssuerAddressFromIssuanceID extracts the issuer's address from a token issuance ID.
@@ -599,20 +961,6 @@ func (b *Blockchain) TransferMPToken(w *wallet.Wallet, issuanceId, to string) (t
 //
 // Returns the issuer's address as a string, or an error if extraction fails.
 func (b *Blockchain) GetIssuerAddressFromIssuanceID(issuanceId string) (issuer string, err error) {
-	if len(issuanceId) != 48 {
-		return "", fmt.Errorf("invalid issuance ID length: expected 56 hex characters, got %d", len(issuanceId))
-	}
-
-	bytes, err := hex.DecodeString(issuanceId)
-	if err != nil {
-		return "", err
-	}
-
-	// Encode account ID bytes to classic address
-	issuerAddr, err := addresscodec.EncodeAccountIDToClassicAddress(bytes[4:])
-	if err != nil {
-		return "", fmt.Errorf("failed to encode account id to classic address: %w", err)
-	}
-
-	return issuerAddr, nil
+	issuer, _, err = DecodeIssuanceID(issuanceId)
+	return issuer, err
 }
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+const (
+	// MinBatchSize is the fewest inner transactions the ledger accepts in a single Batch,
+	// a smaller Batch fails with temARRAY_EMPTY.
+	MinBatchSize = 2
+	// MaxBatchSize is the most inner transactions the ledger accepts in a single Batch,
+	// a larger Batch fails with temARRAY_TOO_LARGE.
+	MaxBatchSize = 8
+)
+
+var (
+	// ErrInvalidBatchSize is returned when a batch holds fewer than MinBatchSize or more than
+	// the configured batch size transactions.
+	ErrInvalidBatchSize = errors.New("invalid batch size")
+)
+
+// SetMaxBatchSize sets the most inner transactions sent in a single Batch. Flows sending
+// more transactions, such as BatchTransferMPTokens, split them into several batches.
+//
+// Parameters:
+// - size: The batch size, zero uses MaxBatchSize
+//
+// Returns an error wrapping ErrInvalidBatchSize if the size is below MinBatchSize or
+// exceeds MaxBatchSize.
+func (b *Blockchain) SetMaxBatchSize(size uint32) error {
+	if size != 0 && size < MinBatchSize {
+		return fmt.Errorf("%w: %d is below the protocol minimum of %d", ErrInvalidBatchSize, size, MinBatchSize)
+	}
+	if size > MaxBatchSize {
+		return fmt.Errorf("%w: %d exceeds the protocol maximum of %d", ErrInvalidBatchSize, size, MaxBatchSize)
+	}
+	b.maxBatchSize = int(size)
+	return nil
+}
+
+// batchSize returns the configured batch size.
+func (b *Blockchain) batchSize() int {
+	if b.maxBatchSize == 0 {
+		return MaxBatchSize
+	}
+	return b.maxBatchSize
+}
+
+// SubmitBatchAndWait submits the transactions as the inner transactions of a single
+// all-or-nothing Batch sent by the wallet, and waits until it is validated. Sending
+// several transactions of an account at once saves round trips and sequence contention.
+// The inner transactions are sent by the same wallet and keep their own fields,
+// such as the destination of a payment.
+//
+// Parameters:
+// - w: The wallet sending the batch and every inner transaction
+// - txs: The inner transactions, at least MinBatchSize and at most the configured batch size
+//
+// Returns the hash of the Batch transaction, or an error if the batch is invalid,
+// cannot be submitted or fails.
+func (b *Blockchain) SubmitBatchAndWait(w *wallet.Wallet, txs []SubmittableTransaction) (txHash string, err error) {
+	if w == nil {
+		return "", fmt.Errorf("wallet cannot be nil")
+	}
+	if len(txs) < MinBatchSize || len(txs) > b.batchSize() {
+		return "", fmt.Errorf("%w: %d transactions, want %d to %d", ErrInvalidBatchSize, len(txs), MinBatchSize, b.batchSize())
+	}
+
+	batch := &transactions.Batch{RawTransactions: make([]types.RawTransaction, len(txs))}
+	batch.SetAllOrNothingFlag()
+	for i, tx := range txs {
+		if tx == nil {
+			return "", fmt.Errorf("transaction cannot be nil")
+		}
+		if err := b.checkTxTypeAllowed(tx.TxType()); err != nil {
+			return "", err
+		}
+		inner := flattenTransaction(tx)
+		inner["Account"] = w.ClassicAddress.String()
+		flags, _ := inner["Flags"].(uint32)
+		inner["Flags"] = flags | types.TfInnerBatchTxn
+		batch.RawTransactions[i] = types.RawTransaction{RawTransaction: inner}
+	}
+
+	// The inner transactions consume the sequences after the batch's own,
+	// so the sequence tracked for the system wallet is read again next time.
+	defer b.sequences.forget(w.ClassicAddress.String())
+	// The inner transactions move the AccountTxnID of the account past the batch
+	defer b.txnIDs.forget(w.ClassicAddress.String())
+
+	return b.SubmitTxAndWait(w, batch)
+}
+
+// BatchTransferMPTokens transfers one unit of an MPT to each destination in Batch transactions.
+// Destinations beyond the configured batch size are split into several batches, submitted
+// in sequence; each batch is all-or-nothing, but a failed batch does not undo the earlier ones.
+// A single remaining destination is sent a plain Payment, as a Batch needs at least
+// MinBatchSize inner transactions.
+//
+// Parameters:
+// - w: The sender's wallet
+// - issuanceID: The ID of the token issuance to transfer
+// - destinations: The destination account addresses
+//
+// Returns the hashes of the Batch and Payment transactions in submission order. On error,
+// the hashes of the transactions already validated are returned with it.
+func (b *Blockchain) BatchTransferMPTokens(w *wallet.Wallet, issuanceID string, destinations []string) (
+	txHashes []string, err error) {
+	if w == nil {
+		return nil, fmt.Errorf("wallet cannot be nil")
+	}
+	if len(destinations) == 0 {
+		return nil, fmt.Errorf("%w: no destinations", ErrInvalidBatchSize)
+	}
+	if err := b.checkMPTokenBalance(w.ClassicAddress.String(), issuanceID, uint64(len(destinations))); err != nil {
+		return nil, err
+	}
+
+	for chunk := range slices.Chunk(destinations, b.batchSize()) {
+		txs := make([]SubmittableTransaction, len(chunk))
+		for i, to := range chunk {
+			txs[i] = &transactions.Payment{
+				Amount:      types.MPTCurrencyAmount{Value: "1", MPTIssuanceID: issuanceID},
+				Destination: types.Address(to),
+			}
+		}
+
+		var txHash string
+		if len(txs) == 1 {
+			txHash, err = b.SubmitTxAndWait(w, txs[0])
+		} else {
+			txHash, err = b.SubmitBatchAndWait(w, txs)
+		}
+		if err != nil {
+			return txHashes, fmt.Errorf("failed to submit batch %d of %d: %w",
+				len(txHashes)+1, (len(destinations)+b.batchSize()-1)/b.batchSize(), err)
+		}
+		txHashes = append(txHashes, txHash)
+	}
+	return txHashes, nil
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_SubmitBatchAndWait_Size(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.SubmitBatchAndWait(bc.w, nil)
+	assert.True(t, errors.Is(err, ErrInvalidBatchSize), "got %v", err)
+
+	_, err = bc.SubmitBatchAndWait(bc.w, []SubmittableTransaction{&transaction.AccountSet{}})
+	assert.True(t, errors.Is(err, ErrInvalidBatchSize), "got %v", err)
+
+	txs := make([]SubmittableTransaction, MaxBatchSize+1)
+	for i := range txs {
+		txs[i] = &transaction.AccountSet{}
+	}
+	_, err = bc.SubmitBatchAndWait(bc.w, txs)
+	assert.True(t, errors.Is(err, ErrInvalidBatchSize), "got %v", err)
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_BatchTransferMPTokens_Split(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	assert.NoError(t, bc.SetMaxBatchSize(3))
+
+	issuanceID, _ := CreateIssuanceID(testAddress, 7)
+	destinations := []string{
+		"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+	}
+
+	hashes, err := bc.BatchTransferMPTokens(bc.w, issuanceID, destinations)
+	assert.NoError(t, err)
+	assert.Len(t, hashes, 3)
+
+	var inner []int
+	for i := 0; i < 2; i++ {
+		tx := submittedTx(t, node, i)
+		assert.Equal(t, "Batch", tx["TransactionType"])
+		raw, _ := tx["RawTransactions"].([]any)
+		inner = append(inner, len(raw))
+	}
+	assert.Equal(t, []int{3, 3}, inner)
+	assert.Equal(t, "Payment", submittedTx(t, node, 2)["TransactionType"])
+}
+
+func TestBlockchain_BatchTransferMPTokens_SingleLeftover(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	issuanceID, _ := CreateIssuanceID(testAddress, 7)
+	destinations := make([]string, MaxBatchSize+1)
+	for i := range destinations {
+		destinations[i] = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	}
+
+	hashes, err := bc.BatchTransferMPTokens(bc.w, issuanceID, destinations)
+	assert.NoError(t, err)
+	assert.Len(t, hashes, 2)
+	assert.Equal(t, 2, node.Calls("submit"))
+
+	batch := submittedTx(t, node, 0)
+	assert.Equal(t, "Batch", batch["TransactionType"])
+	raw, _ := batch["RawTransactions"].([]any)
+	assert.Len(t, raw, MaxBatchSize)
+
+	payment := submittedTx(t, node, 1)
+	assert.Equal(t, "Payment", payment["TransactionType"])
+	assert.Equal(t, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", payment["Destination"])
+	assert.Nil(t, payment["Flags"])
+}
+
+func TestBlockchain_SetMaxBatchSize(t *testing.T) {
+	bc, _ := newTestBlockchain(t, nil)
+
+	assert.Equal(t, MaxBatchSize, bc.batchSize())
+	assert.True(t, errors.Is(bc.SetMaxBatchSize(MaxBatchSize+1), ErrInvalidBatchSize))
+	assert.True(t, errors.Is(bc.SetMaxBatchSize(1), ErrInvalidBatchSize))
+	assert.NoError(t, bc.SetMaxBatchSize(2))
+	assert.Equal(t, 2, bc.batchSize())
+	assert.NoError(t, bc.SetMaxBatchSize(0))
+	assert.Equal(t, MaxBatchSize, bc.batchSize())
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+const (
+	// checkSpace is the ledger space key of Check objects, used to derive their ledger index.
+	checkSpace uint16 = 'C'
+	// ledgerIndexLength is the length of a ledger object ID in hex characters.
+	ledgerIndexLength = 64
+)
+
+var (
+	// ErrInvalidDestination is returned when a destination is not a valid address.
+	ErrInvalidDestination = errors.New("invalid destination address")
+	// ErrInvalidAmount is returned when an amount is malformed or not positive.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrInvalidCheckID is returned when a check ID is not a 64-character hex string.
+	ErrInvalidCheckID = errors.New("invalid check ID")
+	// ErrNotCheckDestination is returned when a check is cashed by an account other than its destination.
+	ErrNotCheckDestination = errors.New("account is not the check destination")
+)
+
+// CheckEntry is the Check ledger object.
+type CheckEntry struct {
+	Index             string        `json:"index,omitempty"`
+	LedgerEntryType   string        `json:"LedgerEntryType"`
+	Account           types.Address `json:"Account"`
+	Destination       types.Address `json:"Destination"`
+	SendMax           any           `json:"SendMax"`
+	Sequence          uint32        `json:"Sequence"`
+	Expiration        uint32        `json:"Expiration,omitempty"`
+	DestinationTag    uint32        `json:"DestinationTag,omitempty"`
+	InvoiceID         types.Hash256 `json:"InvoiceID,omitempty"`
+	Flags             uint32        `json:"Flags"`
+	OwnerNode         string        `json:"OwnerNode,omitempty"`
+	PreviousTxnID     types.Hash256 `json:"PreviousTxnID,omitempty"`
+	PreviousTxnLgrSeq uint32        `json:"PreviousTxnLgrSeq,omitempty"`
+}
+
+// CheckID computes the ledger index of the Check created by the account's transaction with the given sequence.
+//
+// Parameters:
+// - account: The address of the account that created the check
+// - sequence: The sequence of the CheckCreate transaction
+//
+// Returns the check ID as an uppercase hex string, or an error if the address is invalid.
+func CheckID(account string, sequence uint32) (string, error) {
+	_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(account)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode classic address to account id: %w", err)
+	}
+
+	data := make([]byte, 0, 2+len(accountID)+4)
+	data = binary.BigEndian.AppendUint16(data, checkSpace)
+	data = append(data, accountID...)
+	data = binary.BigEndian.AppendUint32(data, sequence)
+
+	sum := sha512.Sum512(data)
+	return strings.ToUpper(hex.EncodeToString(sum[:32])), nil
+}
+
+// validatePositiveAmount checks the amount is well formed and greater than zero.
+func validatePositiveAmount(amount types.CurrencyAmount, fieldName string) error {
+	if ok, err := transaction.IsAmount(amount, fieldName, true); !ok {
+		return fmt.Errorf("%w: %v", ErrInvalidAmount, err)
+	}
+
+	value, err := amountValue(amount)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidAmount, fieldName, err)
+	}
+	if !value.IsPositive() {
+		return fmt.Errorf("%w: %s must be positive", ErrInvalidAmount, fieldName)
+	}
+	return nil
+}
+
+// CreateCheck creates a Check the destination can later cash for up to sendMax.
+// Checks defer settlement to the counterparty instead of paying directly.
+//
+// Parameters:
+// - from: The wallet of the account writing the check
+// - to: The destination account address allowed to cash the check
+// - sendMax: The maximum amount the check can debit the sender
+// - expiration: The time after which the check is no longer valid, in seconds since the Ripple Epoch, may be nil
+//
+// Returns the transaction hash and the check's ledger index, or an error if validation or submission fails.
+func (b *Blockchain) CreateCheck(from *wallet.Wallet, to string, sendMax types.CurrencyAmount, expiration *uint32) (
+	txHash, checkID string, err error) {
+	if from == nil {
+		return "", "", fmt.Errorf("wallet cannot be nil")
+	}
+	if !addresscodec.IsValidAddress(to) {
+		return "", "", fmt.Errorf("%w: %q", ErrInvalidDestination, to)
+	}
+	if to == from.ClassicAddress.String() {
+		return "", "", fmt.Errorf("%w: check cannot be written to its own account", ErrInvalidDestination)
+	}
+	if err := validatePositiveAmount(sendMax, "SendMax"); err != nil {
+		return "", "", err
+	}
+
+	tx := &transaction.CheckCreate{
+		Destination: types.Address(to),
+		SendMax:     sendMax,
+	}
+	if expiration != nil {
+		tx.Expiration = *expiration
+	}
+
+	txHash, sequence, err := b.submitForSequence(from, tx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit tx: %w", err)
+	}
+
+	checkID, err = CheckID(from.ClassicAddress.String(), sequence)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create check id: %w", err)
+	}
+	return txHash, checkID, nil
+}
+
+// GetCheckEntry retrieves the Check ledger object.
+//
+// Parameters:
+// - checkID: The check's ledger index
+//
+// Returns the check object, ErrEntryNotFound if it does not exist, or an error if the request fails.
+func (b *Blockchain) GetCheckEntry(checkID string) (*CheckEntry, error) {
+	node, err := b.GetLedgerEntry(checkID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entry CheckEntry
+	if err := decodeLedgerEntry(node, &entry); err != nil {
+		return nil, err
+	}
+	if entry.LedgerEntryType != "Check" {
+		return nil, fmt.Errorf("%w: ledger entry %s is a %s", ErrEntryNotFound, checkID, entry.LedgerEntryType)
+	}
+	return &entry, nil
+}
+
+// CashCheck redeems a Check for exactly the given amount.
+// The check must exist and the wallet must be its destination.
+//
+// Parameters:
+// - w: The wallet of the check destination
+// - checkID: The check's ledger index
+// - amount: The amount to redeem, in the currency of the check's SendMax
+//
+// Returns the transaction hash if successful, ErrEntryNotFound if the check does not exist,
+// or an error if validation or submission fails.
+func (b *Blockchain) CashCheck(w *wallet.Wallet, checkID string, amount types.CurrencyAmount) (txHash string, err error) {
+	if w == nil {
+		return "", fmt.Errorf("wallet cannot be nil")
+	}
+	if len(checkID) != ledgerIndexLength {
+		return "", fmt.Errorf("%w: expected %d hex characters, got %d", ErrInvalidCheckID, ledgerIndexLength, len(checkID))
+	}
+	if _, err := hex.DecodeString(checkID); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidCheckID, err)
+	}
+	if err := validatePositiveAmount(amount, "Amount"); err != nil {
+		return "", err
+	}
+
+	check, err := b.GetCheckEntry(checkID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get check %s: %w", checkID, err)
+	}
+	if check.Destination != w.ClassicAddress {
+		return "", fmt.Errorf("%w: %s", ErrNotCheckDestination, w.ClassicAddress)
+	}
+
+	return b.SubmitTx(w, &transaction.CheckCash{
+		CheckID: types.Hash256(checkID),
+		Amount:  amount,
+	})
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckID(t *testing.T) {
+	id, err := CheckID(testAddress, 10)
+	assert.NoError(t, err)
+	assert.Len(t, id, ledgerIndexLength)
+
+	other, err := CheckID(testAddress, 11)
+	assert.NoError(t, err)
+	assert.NotEqual(t, id, other)
+
+	_, err = CheckID("rInvalid", 10)
+	assert.Error(t, err)
+}
+
+func TestBlockchain_CreateCheck(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	destination := "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	expiration := uint32(800000000)
+
+	hash, checkID, err := bc.CreateCheck(bc.w, destination, types.XRPCurrencyAmount(1000), &expiration)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "CheckCreate", tx["TransactionType"])
+	assert.Equal(t, destination, tx["Destination"])
+	assert.Equal(t, "1000", tx["SendMax"])
+	assert.Equal(t, expiration, tx["Expiration"])
+
+	expected, err := CheckID(testAddress, tx["Sequence"].(uint32))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, checkID)
+}
+
+func TestBlockchain_CreateCheck_Invalid(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	_, _, err := bc.CreateCheck(bc.w, "rInvalid", types.XRPCurrencyAmount(1000), nil)
+	assert.True(t, errors.Is(err, ErrInvalidDestination))
+
+	_, _, err = bc.CreateCheck(bc.w, testAddress, types.XRPCurrencyAmount(1000), nil)
+	assert.True(t, errors.Is(err, ErrInvalidDestination))
+
+	_, _, err = bc.CreateCheck(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", types.XRPCurrencyAmount(0), nil)
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	_, _, err = bc.CreateCheck(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", nil, nil)
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_CashCheck(t *testing.T) {
+	checkID, err := CheckID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 5)
+	assert.NoError(t, err)
+
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = func(params map[string]any) map[string]any {
+		return map[string]any{
+			"index": checkID,
+			"node": map[string]any{
+				"LedgerEntryType": "Check",
+				"Account":         "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"Destination":     testAddress,
+				"SendMax":         "1000",
+				"Sequence":        5,
+				"Flags":           0,
+				"index":           checkID,
+			},
+			"validated": true,
+		}
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	hash, err := bc.CashCheck(bc.w, checkID, types.XRPCurrencyAmount(500))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, checkID, node.Params("ledger_entry")[0]["index"])
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "CheckCash", tx["TransactionType"])
+	assert.Equal(t, checkID, tx["CheckID"])
+	assert.Equal(t, "500", tx["Amount"])
+}
+
+func TestBlockchain_CashCheck_NotFound(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = fixtureResult(entryNotFoundFixture)
+	bc, node := newTestBlockchain(t, handlers)
+
+	checkID, err := CheckID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 5)
+	assert.NoError(t, err)
+
+	_, err = bc.CashCheck(bc.w, checkID, types.XRPCurrencyAmount(500))
+	assert.True(t, errors.Is(err, ErrEntryNotFound), "got %v", err)
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestBlockchain_CashCheck_NotDestination(t *testing.T) {
+	checkID, err := CheckID(testAddress, 5)
+	assert.NoError(t, err)
+
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = func(params map[string]any) map[string]any {
+		return map[string]any{
+			"node": map[string]any{
+				"LedgerEntryType": "Check",
+				"Account":         testAddress,
+				"Destination":     "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"SendMax":         "1000",
+			},
+			"validated": true,
+		}
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	_, err = bc.CashCheck(bc.w, checkID, types.XRPCurrencyAmount(500))
+	assert.True(t, errors.Is(err, ErrNotCheckDestination))
+	assert.Equal(t, 0, node.Calls("submit"))
+}
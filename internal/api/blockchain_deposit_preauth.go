@@ -0,0 +1,69 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+var (
+	// ErrCannotPreauthSelf is returned when an account preauthorizes or unauthorizes itself,
+	// which the ledger rejects with temCANNOT_PREAUTH_SELF.
+	ErrCannotPreauthSelf = errors.New("account cannot preauthorize itself")
+)
+
+// DepositPreauth preauthorizes an account to send payments to the wallet's account.
+// An account with DepositAuth enabled rejects payments from other accounts with
+// tecNO_PERMISSION unless it preauthorized them, such as the system account.
+//
+// Parameters:
+// - w: The wallet of the account receiving the payments
+// - authorize: The address of the sender to preauthorize
+//
+// Returns the transaction hash, an error wrapping ErrInvalidAddress or ErrCannotPreauthSelf
+// if the sender is invalid, or an error if the submission fails.
+func (b *Blockchain) DepositPreauth(w *wallet.Wallet, authorize string) (txHash string, err error) {
+	if err := validatePreauthAddress(w, authorize); err != nil {
+		return "", err
+	}
+	return b.SubmitTx(w, &transaction.DepositPreauth{
+		Authorize: types.Address(authorize),
+	})
+}
+
+// DepositUnauthorize revokes the preauthorization of an account to send payments to the
+// wallet's account, see DepositPreauth.
+//
+// Parameters:
+// - w: The wallet of the account receiving the payments
+// - unauthorize: The address of the sender whose preauthorization is revoked
+//
+// Returns the transaction hash, an error wrapping ErrInvalidAddress or ErrCannotPreauthSelf
+// if the sender is invalid, or an error if the submission fails.
+func (b *Blockchain) DepositUnauthorize(w *wallet.Wallet, unauthorize string) (txHash string, err error) {
+	if err := validatePreauthAddress(w, unauthorize); err != nil {
+		return "", err
+	}
+	return b.SubmitTx(w, &transaction.DepositPreauth{
+		Unauthorize: types.Address(unauthorize),
+	})
+}
+
+// validatePreauthAddress checks the wallet is set and the sender is a valid classic address
+// other than the wallet's own account.
+func validatePreauthAddress(w *wallet.Wallet, sender string) error {
+	if w == nil {
+		return fmt.Errorf("wallet cannot be nil")
+	}
+	if !addresscodec.IsValidClassicAddress(sender) {
+		return fmt.Errorf("%w: %q", ErrInvalidAddress, sender)
+	}
+	if sender == w.ClassicAddress.String() {
+		return fmt.Errorf("%w: %s", ErrCannotPreauthSelf, sender)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_DepositPreauth(t *testing.T) {
+	const sender = "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.DepositPreauth(bc.w, sender)
+	assert.NoError(t, err)
+	_, err = bc.DepositUnauthorize(bc.w, sender)
+	assert.NoError(t, err)
+
+	preauth := submittedTx(t, node, 0)
+	assert.Equal(t, string(transaction.DepositPreauthTx), preauth["TransactionType"])
+	assert.Equal(t, sender, preauth["Authorize"])
+	unauth := submittedTx(t, node, 1)
+	assert.Equal(t, sender, unauth["Unauthorize"])
+	assert.NotContains(t, unauth, "Authorize")
+}
+
+func TestBlockchain_DepositPreauth_Self(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.DepositPreauth(bc.w, testAddress)
+	assert.True(t, errors.Is(err, ErrCannotPreauthSelf), "got %v", err)
+	_, err = bc.DepositUnauthorize(bc.w, testAddress)
+	assert.True(t, errors.Is(err, ErrCannotPreauthSelf), "got %v", err)
+
+	_, err = bc.DepositPreauth(bc.w, "rInvalid")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+	assert.Zero(t, node.Calls("submit"))
+}
@@ -0,0 +1,172 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	rippletime "github.com/Peersyst/xrpl-go/xrpl/time"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+const (
+	// escrowFinishBaseMultiplier is the base fee multiplier of an EscrowFinish carrying a fulfillment.
+	escrowFinishBaseMultiplier = 33
+	// escrowFulfillmentChunk is the fulfillment size, in bytes, charged one more base fee.
+	escrowFulfillmentChunk = 16
+)
+
+var (
+	// ErrInvalidEscrowTimes is returned when the FinishAfter and CancelAfter times of an escrow are inconsistent.
+	ErrInvalidEscrowTimes = errors.New("invalid escrow times")
+	// ErrInvalidEscrowCondition is returned when an escrow condition or fulfillment is missing.
+	ErrInvalidEscrowCondition = errors.New("invalid escrow condition")
+)
+
+// toRippleTime converts a time to seconds since the Ripple Epoch.
+func toRippleTime(t time.Time) uint32 {
+	return uint32(t.Unix() - rippletime.RippleEpochDiff)
+}
+
+// EscrowFinishFee returns the fee of an EscrowFinish transaction the way the rpc client's
+// autofill computes it: a fulfillment costs BaseFee × (33 + ceil(fulfillment bytes / 16)),
+// otherwise the base fee applies.
+//
+// Parameters:
+// - baseFee: The network base fee in drops, including the load factor and fee cushion
+// - fulfillment: The hex encoded fulfillment, may be empty
+//
+// Returns the fee in drops.
+func EscrowFinishFee(baseFee uint64, fulfillment string) uint64 {
+	if fulfillment == "" {
+		return baseFee
+	}
+	fulfillmentBytes := uint64(len(fulfillment)+1) / 2
+	chunks := (fulfillmentBytes + escrowFulfillmentChunk - 1) / escrowFulfillmentChunk
+	return baseFee * (escrowFinishBaseMultiplier + chunks)
+}
+
+// validateEscrowTimes checks the escrow can be finished or cancelled and that it can be finished
+// before it expires, mirroring the temBAD_EXPIRATION checks of the node.
+func validateEscrowTimes(finishAfter, cancelAfter time.Time, condition string, now time.Time) error {
+	if finishAfter.IsZero() && cancelAfter.IsZero() {
+		return fmt.Errorf("%w: FinishAfter or CancelAfter is required", ErrInvalidEscrowTimes)
+	}
+	if finishAfter.IsZero() && condition == "" {
+		return fmt.Errorf("%w: FinishAfter is required without a condition", ErrInvalidEscrowTimes)
+	}
+	if !cancelAfter.IsZero() {
+		if !cancelAfter.After(now) {
+			return fmt.Errorf("%w: CancelAfter %s is in the past", ErrInvalidEscrowTimes, cancelAfter)
+		}
+		if !finishAfter.IsZero() && !cancelAfter.After(finishAfter) {
+			return fmt.Errorf("%w: CancelAfter %s must be after FinishAfter %s", ErrInvalidEscrowTimes, cancelAfter, finishAfter)
+		}
+	}
+	return nil
+}
+
+// CreateEscrow locks XRP until it is released to the destination or returned to the sender.
+// The escrow can be finished after finishAfter (and, with a condition, by presenting its fulfillment),
+// and cancelled after cancelAfter.
+//
+// Parameters:
+// - from: The wallet of the account funding the escrow
+// - to: The destination account address
+// - amount: The amount of XRP to lock, in drops
+// - finishAfter: The time after which the escrow can be finished, zero for none
+// - cancelAfter: The time after which the escrow can be cancelled, zero for none
+// - condition: The hex encoded PREIMAGE-SHA-256 crypto-condition, may be empty
+//
+// Returns the transaction hash and its sequence, which identifies the escrow when finishing
+// or cancelling it, or an error if validation or submission fails.
+func (b *Blockchain) CreateEscrow(from *wallet.Wallet, to string, amount types.XRPCurrencyAmount,
+	finishAfter, cancelAfter time.Time, condition string) (txHash string, offerSequence uint32, err error) {
+	if from == nil {
+		return "", 0, fmt.Errorf("wallet cannot be nil")
+	}
+	if !addresscodec.IsValidAddress(to) {
+		return "", 0, fmt.Errorf("%w: %q", ErrInvalidDestination, to)
+	}
+	if amount == 0 {
+		return "", 0, fmt.Errorf("%w: Amount must be positive", ErrInvalidAmount)
+	}
+	if err := validateEscrowTimes(finishAfter, cancelAfter, condition, time.Now()); err != nil {
+		return "", 0, err
+	}
+
+	tx := &transaction.EscrowCreate{
+		Amount:      amount,
+		Destination: types.Address(to),
+		Condition:   condition,
+	}
+	if !finishAfter.IsZero() {
+		tx.FinishAfter = toRippleTime(finishAfter)
+	}
+	if !cancelAfter.IsZero() {
+		tx.CancelAfter = toRippleTime(cancelAfter)
+	}
+
+	txHash, offerSequence, err = b.submitForSequence(from, tx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to submit tx: %w", err)
+	}
+	return txHash, offerSequence, nil
+}
+
+// FinishEscrow delivers the escrowed XRP to its destination.
+// For a conditional escrow the fee grows with the fulfillment size, see EscrowFinishFee;
+// the rpc client's autofill applies it.
+//
+// Parameters:
+// - w: The wallet submitting the transaction, any account can finish an escrow
+// - owner: The address of the account that created the escrow
+// - offerSequence: The sequence of the EscrowCreate transaction
+// - condition: The hex encoded condition of the escrow, empty for a time-based escrow
+// - fulfillment: The hex encoded fulfillment matching the condition, empty for a time-based escrow
+//
+// Returns the transaction hash if successful, or an error if validation or submission fails.
+func (b *Blockchain) FinishEscrow(w *wallet.Wallet, owner string, offerSequence uint32,
+	condition, fulfillment string) (txHash string, err error) {
+	if w == nil {
+		return "", fmt.Errorf("wallet cannot be nil")
+	}
+	if !addresscodec.IsValidClassicAddress(owner) {
+		return "", fmt.Errorf("invalid escrow owner: %q", owner)
+	}
+	if (condition == "") != (fulfillment == "") {
+		return "", fmt.Errorf("%w: condition and fulfillment must be provided together", ErrInvalidEscrowCondition)
+	}
+
+	return b.SubmitTx(w, &transaction.EscrowFinish{
+		Owner:         types.Address(owner),
+		OfferSequence: offerSequence,
+		Condition:     condition,
+		Fulfillment:   fulfillment,
+	})
+}
+
+// CancelEscrow returns the escrowed XRP to its owner once the escrow has expired.
+//
+// Parameters:
+// - w: The wallet submitting the transaction, any account can cancel an expired escrow
+// - owner: The address of the account that created the escrow
+// - offerSequence: The sequence of the EscrowCreate transaction
+//
+// Returns the transaction hash if successful, or an error if submission fails.
+func (b *Blockchain) CancelEscrow(w *wallet.Wallet, owner string, offerSequence uint32) (txHash string, err error) {
+	if w == nil {
+		return "", fmt.Errorf("wallet cannot be nil")
+	}
+	if !addresscodec.IsValidClassicAddress(owner) {
+		return "", fmt.Errorf("invalid escrow owner: %q", owner)
+	}
+
+	return b.SubmitTx(w, &transaction.EscrowCancel{
+		Owner:         types.Address(owner),
+		OfferSequence: offerSequence,
+	})
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// PREIMAGE-SHA-256 condition and fulfillment of a 32-byte preimage
+	escrowCondition   = "A0258020" + "66687AADF862BD776C8FC18B8E9F8E20089714856EE233B3902A591D0D5F2925" + "810120"
+	escrowFulfillment = "A0228020" + "0000000000000000000000000000000000000000000000000000000000000000"
+	// stubBaseFee is the fee autofilled from the stub server_info: 10 drops with a 1.2 cushion
+	stubBaseFee = 12
+)
+
+func TestEscrowFinishFee(t *testing.T) {
+	assert.Equal(t, uint64(10), EscrowFinishFee(10, ""))
+	// 4 bytes: one chunk
+	assert.Equal(t, uint64(10*34), EscrowFinishFee(10, "A0028000"))
+	// 16 bytes: still one chunk
+	assert.Equal(t, uint64(10*34), EscrowFinishFee(10, strings.Repeat("00", 16)))
+	// 17 bytes: two chunks
+	assert.Equal(t, uint64(10*35), EscrowFinishFee(10, strings.Repeat("00", 17)))
+	// 36 bytes: three chunks
+	assert.Equal(t, uint64(10*36), EscrowFinishFee(10, escrowFulfillment))
+}
+
+func TestBlockchain_FinishEscrow_Fee(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	owner := "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+
+	hash, err := bc.FinishEscrow(bc.w, owner, 7, escrowCondition, escrowFulfillment)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "EscrowFinish", tx["TransactionType"])
+	assert.Equal(t, owner, tx["Owner"])
+	assert.Equal(t, uint32(7), tx["OfferSequence"])
+
+	// base * (33 + ceil(36 / 16))
+	fulfillmentBytes := len(escrowFulfillment) / 2
+	expected := uint64(stubBaseFee * (33 + (fulfillmentBytes+15)/16))
+	assert.Equal(t, strconv.FormatUint(expected, 10), tx["Fee"])
+	assert.Equal(t, strconv.FormatUint(EscrowFinishFee(stubBaseFee, escrowFulfillment), 10), tx["Fee"])
+}
+
+func TestBlockchain_FinishEscrow_MissingFulfillment(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	_, err := bc.FinishEscrow(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7, escrowCondition, "")
+	assert.True(t, errors.Is(err, ErrInvalidEscrowCondition))
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_CreateEscrow(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	destination := "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	finishAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+	cancelAfter := finishAfter.Add(24 * time.Hour)
+
+	hash, sequence, err := bc.CreateEscrow(bc.w, destination, types.XRPCurrencyAmount(1000), finishAfter, cancelAfter, "")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "EscrowCreate", tx["TransactionType"])
+	assert.Equal(t, destination, tx["Destination"])
+	assert.Equal(t, tx["Sequence"], sequence)
+	assert.Equal(t, uint32(finishAfter.Unix()-946684800), tx["FinishAfter"])
+	assert.Equal(t, uint32(cancelAfter.Unix()-946684800), tx["CancelAfter"])
+}
+
+func TestValidateEscrowTimes(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name        string
+		finishAfter time.Time
+		cancelAfter time.Time
+		condition   string
+		wantErr     bool
+	}{
+		{name: "finish only", finishAfter: now.Add(time.Hour)},
+		{name: "finish before cancel", finishAfter: now.Add(time.Hour), cancelAfter: now.Add(2 * time.Hour)},
+		{name: "condition and cancel", cancelAfter: now.Add(time.Hour), condition: escrowCondition},
+		{name: "no times", wantErr: true},
+		{name: "cancel without condition", cancelAfter: now.Add(time.Hour), wantErr: true},
+		{name: "cancel before finish", finishAfter: now.Add(2 * time.Hour), cancelAfter: now.Add(time.Hour), wantErr: true},
+		{name: "cancel equals finish", finishAfter: now.Add(time.Hour), cancelAfter: now.Add(time.Hour), wantErr: true},
+		{name: "cancel in the past", finishAfter: now.Add(-2 * time.Hour), cancelAfter: now.Add(-time.Hour), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEscrowTimes(tt.finishAfter, tt.cancelAfter, tt.condition, now)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidEscrowTimes), "got %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBlockchain_CancelEscrow(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.CancelEscrow(bc.w, testAddress, 9)
+	assert.NoError(t, err)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "EscrowCancel", tx["TransactionType"])
+	assert.Equal(t, testAddress, tx["Owner"])
+	assert.Equal(t, uint32(9), tx["OfferSequence"])
+}
@@ -1,13 +1,13 @@
 package api
 
 import (
+	"errors"
 	"fmt"
-	"strconv"
 	"time"
 
 	"github.com/Peersyst/xrpl-go/xrpl/transaction"
-	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -24,47 +24,115 @@ func (b *Blockchain) SystemAccountInit() error {
 	accountSet := &transaction.AccountSet{}
 	accountSet.SetAsfDefaultRipple()
 
-	return b.SubmitTxAndWait(b.w, accountSet)
+	_, err := b.SubmitTxAndWait(b.SystemWallet(), accountSet)
+	return err
 }
 
 func (b *Blockchain) CreateTrustline(from, to *wallet.Wallet, amount float64) error {
+	limit, err := NewIssuedAmount(formatIssuedValue(amount), RLUSDHex, from.ClassicAddress.String())
+	if err != nil {
+		return err
+	}
 	trustline := &transaction.TrustSet{
-		LimitAmount: types.IssuedCurrencyAmount{
-			Issuer:   from.ClassicAddress,
-			Currency: RLUSDHex,
-			Value:    strconv.FormatFloat(amount, 'f', -1, 64),
-		},
+		LimitAmount: limit,
 	}
 	trustline.SetClearNoRippleFlag()
 
-	return b.SubmitTxAndWait(to, trustline)
+	_, err = b.SubmitTxAndWait(to, trustline)
+	return err
 }
 
-func (b *Blockchain) CreateTrustlineFromSystemAccount(to *wallet.Wallet, amount float64) error {
-	if err := b.CreateTrustline(b.w, to, amount); err != nil {
-		return fmt.Errorf("failed to create trustline from system account: %v", err)
+// CreateTrustlineFromSystemAccount makes the account trust the system account's RLUSD up to amount
+// and clears NoRipple on the system account's side, so RLUSD can ripple between holders.
+// Each side is only submitted when missing: an existing limit of at least amount is kept,
+// a lower one is raised.
+//
+// Parameters:
+// - to: The wallet of the holder account
+// - amount: The minimum RLUSD limit of the holder's trustline
+//
+// Returns whether any transaction was sent, or an error if the trustline cannot be read or set.
+func (b *Blockchain) CreateTrustlineFromSystemAccount(to *wallet.Wallet, amount float64) (sent bool, err error) {
+	line, err := b.GetTrustline(to.ClassicAddress.String(), RLUSDHex, b.SystemWallet().ClassicAddress.String())
+	if err != nil && !errors.Is(err, ErrTrustlineNotFound) {
+		return false, err
+	}
+
+	if line == nil || !trustlineLimitCovers(line.Limit, amount) {
+		if err := b.CreateTrustline(b.SystemWallet(), to, amount); err != nil {
+			return false, fmt.Errorf("failed to create trustline from system account: %v", err)
+		}
+		sent = true
+	}
+
+	// NoRipplePeer is the system account's NoRipple flag on the line, as seen from the holder
+	if line == nil || line.NoRipplePeer {
+		if err := b.CreateTrustline(to, b.SystemWallet(), 0); err != nil {
+			return sent, err
+		}
+		sent = true
 	}
 
-	return b.CreateTrustline(to, b.w, 0)
+	return sent, nil
+}
+
+// trustlineLimitCovers reports whether an existing trustline limit is at least amount.
+// An unparsable limit never covers, so the trustline is set again.
+func trustlineLimitCovers(limit string, amount float64) bool {
+	current, err := decimal.NewFromString(limit)
+	if err != nil {
+		return false
+	}
+	return current.GreaterThanOrEqual(decimal.NewFromFloat(amount))
 }
 
-func (b *Blockchain) PaymentRLUSDFromSystemAccount(to *wallet.Wallet, amount float64) error {
-	return b.PaymentRLUSD(b.w, to, amount)
+func (b *Blockchain) PaymentRLUSDFromSystemAccount(to *wallet.Wallet, amount float64) (txHash string, err error) {
+	return b.PaymentRLUSD(b.SystemWallet(), to, amount)
 }
 
-func (b *Blockchain) PaymentRLUSDToSystemAccount(from *wallet.Wallet, amount float64) error {
-	return b.PaymentRLUSD(from, b.w, amount)
+func (b *Blockchain) PaymentRLUSDToSystemAccount(from *wallet.Wallet, amount float64) (txHash string, err error) {
+	return b.PaymentRLUSD(from, b.SystemWallet(), amount)
 }
 
-func (b *Blockchain) PaymentRLUSD(from, to *wallet.Wallet, amount float64) error {
+func (b *Blockchain) PaymentRLUSD(from, to *wallet.Wallet, amount float64) (txHash string, err error) {
+	value, err := NewIssuedAmount(formatIssuedValue(amount), RLUSDHex, b.SystemWallet().ClassicAddress.String())
+	if err != nil {
+		return "", err
+	}
 	payment := &transaction.Payment{
-		Amount: types.IssuedCurrencyAmount{
-			Issuer:   b.w.ClassicAddress,
-			Currency: RLUSDHex,
-			Value:    strconv.FormatFloat(amount, 'f', -1, 64),
-		},
+		Amount:      value,
 		Destination: to.ClassicAddress,
 	}
 
 	return b.SubmitTxAndWait(from, payment)
 }
+
+// RLUSDPayment is a single RLUSD payment of a batch.
+type RLUSDPayment struct {
+	To     *wallet.Wallet
+	Amount float64
+}
+
+// PaymentRLUSDBatch sends several RLUSD payments from one wallet in a single all-or-nothing
+// Batch, each payment keeping its own destination.
+//
+// Parameters:
+// - from: The wallet paying every payment
+// - payments: The payments, at most the configured batch size
+//
+// Returns the hash of the Batch transaction, or an error if any payment cannot be made.
+func (b *Blockchain) PaymentRLUSDBatch(from *wallet.Wallet, payments []RLUSDPayment) (txHash string, err error) {
+	txs := make([]SubmittableTransaction, len(payments))
+	for i, p := range payments {
+		value, err := NewIssuedAmount(formatIssuedValue(p.Amount), RLUSDHex, b.SystemWallet().ClassicAddress.String())
+		if err != nil {
+			return "", err
+		}
+		txs[i] = &transaction.Payment{
+			Amount:      value,
+			Destination: p.To.ClassicAddress,
+		}
+	}
+
+	return b.SubmitBatchAndWait(from, txs)
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+// trustlineHandlers answers account_lines with an RLUSD trustline of the holder to the system account.
+func trustlineHandlers(limit string, noRipplePeer bool) map[string]rpcHandler {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["account_lines"] = func(params map[string]any) map[string]any {
+		return map[string]any{
+			"account": params["account"],
+			"lines": []map[string]any{{
+				"account":        testAddress,
+				"currency":       RLUSDHex,
+				"balance":        "0",
+				"limit":          limit,
+				"limit_peer":     "0",
+				"no_ripple_peer": noRipplePeer,
+			}},
+		}
+	}
+	return handlers
+}
+
+func TestBlockchain_CreateTrustlineFromSystemAccount_Exists(t *testing.T) {
+	holder, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	bc, node := newTestBlockchain(t, trustlineHandlers("1000", false))
+
+	sent, err := bc.CreateTrustlineFromSystemAccount(holder, 1000)
+	assert.NoError(t, err)
+	assert.False(t, sent)
+	assert.Equal(t, 0, node.Calls("submit"))
+
+	sent, err = bc.CreateTrustlineFromSystemAccount(holder, 999.5)
+	assert.NoError(t, err)
+	assert.False(t, sent)
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestBlockchain_CreateTrustlineFromSystemAccount_RaisesLimit(t *testing.T) {
+	holder, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	bc, node := newTestBlockchain(t, trustlineHandlers("100", false))
+
+	sent, err := bc.CreateTrustlineFromSystemAccount(holder, 1000)
+	assert.NoError(t, err)
+	assert.True(t, sent)
+
+	// Only the holder's side is set again, the system side already ripples
+	if assert.Equal(t, 1, node.Calls("submit")) {
+		tx := submittedTx(t, node, 0)
+		assert.Equal(t, string(transaction.TrustSetTx), tx["TransactionType"])
+		assert.Equal(t, holder.ClassicAddress.String(), tx["Account"])
+		assert.Equal(t, "1000", tx["LimitAmount"].(map[string]any)["value"])
+	}
+}
+
+func TestBlockchain_CreateTrustlineFromSystemAccount_Missing(t *testing.T) {
+	holder, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	sent, err := bc.CreateTrustlineFromSystemAccount(holder, 1000)
+	assert.NoError(t, err)
+	assert.True(t, sent)
+
+	if assert.Equal(t, 2, node.Calls("submit")) {
+		assert.Equal(t, holder.ClassicAddress.String(), submittedTx(t, node, 0)["Account"])
+		assert.Equal(t, testAddress, submittedTx(t, node, 1)["Account"])
+	}
+}
+
+func TestBlockchain_EnsureTrustlineCapacity(t *testing.T) {
+	holder, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["account_lines"] = func(params map[string]any) map[string]any {
+		return map[string]any{
+			"account": params["account"],
+			"lines": []map[string]any{
+				{"account": testAddress, "currency": RLUSDHex, "balance": "900", "limit": "1000"},
+			},
+		}
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	// 100 more still fits under the limit
+	assert.NoError(t, bc.EnsureTrustlineCapacity(holder, RLUSDHex, testAddress, decimal.NewFromInt(100)))
+	assert.Equal(t, 0, node.Calls("submit"))
+
+	// 500 more needs a limit of 1400
+	assert.NoError(t, bc.EnsureTrustlineCapacity(holder, RLUSDHex, testAddress, decimal.NewFromInt(500)))
+	if assert.Equal(t, 1, node.Calls("submit")) {
+		tx := submittedTx(t, node, 0)
+		assert.Equal(t, string(transaction.TrustSetTx), tx["TransactionType"])
+		assert.Equal(t, holder.ClassicAddress.String(), tx["Account"])
+		limit := tx["LimitAmount"].(map[string]any)
+		assert.Equal(t, "1400", limit["value"])
+		assert.Equal(t, testAddress, limit["issuer"])
+	}
+}
+
+func TestBlockchain_GetTrustlineBalance(t *testing.T) {
+	const holder = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	handlers := submitHandlers("tesSUCCESS")
+	// The node reports each side of the line with the balance signed for the queried account
+	handlers["account_lines"] = func(params map[string]any) map[string]any {
+		line := map[string]any{"account": testAddress, "currency": RLUSDHex, "balance": "125.5", "limit": "1000"}
+		if params["account"] == testAddress {
+			line = map[string]any{"account": holder, "currency": RLUSDHex, "balance": "-125.5", "limit": "0"}
+		}
+		return map[string]any{"account": params["account"], "lines": []map[string]any{line}}
+	}
+	bc, _ := newTestBlockchain(t, handlers)
+
+	balance, err := bc.GetTrustlineBalance(holder, RLUSDHex, testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, "125.5", balance.String())
+
+	balance, err = bc.GetTrustlineBalance(testAddress, RLUSDHex, holder)
+	assert.NoError(t, err)
+	assert.Equal(t, "-125.5", balance.String())
+
+	balance, err = bc.GetTrustlineBalance(holder, "USD", testAddress)
+	assert.NoError(t, err)
+	assert.True(t, balance.IsZero())
+}
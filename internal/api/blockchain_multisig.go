@@ -0,0 +1,230 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+var (
+	// ErrNoSignerList is returned when a multi-signed transaction is sent from an account without a signer list.
+	ErrNoSignerList = errors.New("account has no signer list")
+	// ErrSignerNotListed is returned when a signer is not in the signer list of the sending account.
+	ErrSignerNotListed = errors.New("signer is not in the signer list")
+	// ErrQuorumNotMet is returned when the weights of the signers do not reach the signer list quorum.
+	ErrQuorumNotMet = errors.New("signer quorum not met")
+)
+
+// GetSignerList retrieves the signer list of an account.
+//
+// Parameters:
+// - address: The XRPL account address to query
+//
+// Returns the signer list, or an error wrapping ErrNoSignerList if the account has none.
+func (b *Blockchain) GetSignerList(address string) (*ledger.SignerList, error) {
+	info, err := b.c.GetAccountInfo(&account.InfoRequest{
+		Account:     types.Address(address),
+		LedgerIndex: common.Validated,
+		SignerLists: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+	if len(info.SignerLists) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoSignerList, address)
+	}
+	return &info.SignerLists[0], nil
+}
+
+// checkQuorum checks the signers are distinct members of the signer list whose weights
+// reach its quorum, so that a transaction the node would reject is never submitted.
+func checkQuorum(list *ledger.SignerList, signers []*wallet.Wallet) error {
+	weights := make(map[string]uint32, len(list.SignerEntries))
+	for _, entry := range list.SignerEntries {
+		weights[entry.SignerEntry.Account.String()] = uint32(entry.SignerEntry.SignerWeight)
+	}
+
+	var total uint32
+	seen := make(map[string]bool, len(signers))
+	for _, signer := range signers {
+		address := signer.ClassicAddress.String()
+		if seen[address] {
+			return fmt.Errorf("duplicate signer %s", address)
+		}
+		seen[address] = true
+
+		weight, ok := weights[address]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrSignerNotListed, address)
+		}
+		total += weight
+	}
+
+	if total < list.SignerQuorum {
+		return fmt.Errorf("%w: signers weigh %d, quorum is %d", ErrQuorumNotMet, total, list.SignerQuorum)
+	}
+	return nil
+}
+
+// SubmitMultisignedTx submits a transaction on behalf of an account, signed by members
+// of its signer list. The quorum is verified against the validated signer list first.
+//
+// Parameters:
+// - address: The account the transaction is sent from
+// - signers: The wallets of the signer list members signing the transaction
+// - tx: The transaction to submit
+//
+// Returns the transaction hash, or an error if the quorum is not met or submission fails.
+func (b *Blockchain) SubmitMultisignedTx(address string, signers []*wallet.Wallet, tx SubmittableTransaction) (
+	hash string, err error) {
+	if len(signers) == 0 {
+		return "", fmt.Errorf("signers cannot be empty")
+	}
+	if tx == nil {
+		return "", fmt.Errorf("transaction cannot be nil")
+	}
+	if err := b.checkTxTypeAllowed(tx.TxType()); err != nil {
+		return "", err
+	}
+	var fee uint64
+	defer func(start time.Time) { b.observeSubmit(tx.TxType(), start, fee, err) }(time.Now())
+
+	list, err := b.GetSignerList(address)
+	if err != nil {
+		return "", err
+	}
+	if err := checkQuorum(list, signers); err != nil {
+		return "", err
+	}
+
+	flattenedTx := flattenTransaction(tx)
+	flattenedTx["Account"] = address
+	flattenedTx["SigningPubKey"] = ""
+	b.addCorrelationMemo(flattenedTx)
+	defer b.accounts.invalidate(address)
+	if err := resolveXAddresses(flattenedTx); err != nil {
+		return "", fmt.Errorf("invalid transaction: %w", err)
+	}
+	if err := b.setLastLedgerSequence(flattenedTx); err != nil {
+		return "", err
+	}
+	if err := b.c.AutofillMultisigned(&flattenedTx, uint64(len(signers))); err != nil {
+		return "", fmt.Errorf("failed to autofill tx: %w", err)
+	}
+
+	blob, err := multisign(flattenedTx, signers)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := b.c.SubmitMultisigned(blob, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit tx: %w", err)
+	}
+	fee = feeDrops(resp.Tx)
+	if resp.EngineResult != string(transactions.TesSUCCESS) {
+		return "", fmt.Errorf("failed to submit tx: %w", &EngineError{
+			Result:        transactions.TxResult(resp.EngineResult),
+			ResultMessage: resp.EngineResultMessage,
+		})
+	}
+
+	hash, _ = resp.Tx["hash"].(string)
+	if hash == "" {
+		return "", fmt.Errorf("hash is empty")
+	}
+	b.audit(tx.TxType(), address, resp.Tx)
+
+	return hash, nil
+}
+
+// multisign signs the autofilled transaction with every signer and encodes it with the
+// Signers array sorted by account ID, as the node requires.
+func multisign(tx transactions.FlatTransaction, signers []*wallet.Wallet) (string, error) {
+	type signature struct {
+		accountID []byte
+		signer    any
+	}
+
+	signatures := make([]signature, 0, len(signers))
+	for _, w := range signers {
+		signed := maps.Clone(tx)
+		if _, _, err := w.Multisign(signed); err != nil {
+			return "", fmt.Errorf("failed to sign tx by %s: %w", w.ClassicAddress, err)
+		}
+		_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(w.ClassicAddress.String())
+		if err != nil {
+			return "", fmt.Errorf("invalid signer address %s: %w", w.ClassicAddress, err)
+		}
+		signatures = append(signatures, signature{accountID: accountID, signer: signed["Signers"].([]any)[0]})
+	}
+	slices.SortFunc(signatures, func(a, b signature) int {
+		return bytes.Compare(a.accountID, b.accountID)
+	})
+
+	txSigners := make([]any, len(signatures))
+	for i, s := range signatures {
+		txSigners[i] = s.signer
+	}
+	tx["Signers"] = txSigners
+
+	blob, err := binarycodec.Encode(tx)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode multisigned tx: %w", err)
+	}
+	if err := checkCanonicalBlob(blob); err != nil {
+		return "", err
+	}
+	return blob, nil
+}
+
+// TransferMPTokenMultisig transfers one unit of an MPT from an account whose signer list
+// requires several signatures, such as an institutional creditor. WithValidation has no
+// effect: multi-signed transfers return as soon as the node accepts them.
+//
+// Parameters:
+// - from: The multi-signing account holding the token
+// - signers: The wallets of the signer list members signing the transfer
+// - issuanceID: The MPT issuance ID
+// - to: The recipient account address
+// - opts: Options such as WithoutBalanceCheck
+//
+// Returns the transaction hash, or an error if the sender holds too little of the token,
+// the quorum is not met or submission fails.
+func (b *Blockchain) TransferMPTokenMultisig(from string, signers []*wallet.Wallet, issuanceID, to string,
+	opts ...TransferOption) (txHash string, err error) {
+	var o transferOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	const amount = 1
+	if !o.skipBalanceCheck {
+		if err := b.checkMPTokenBalance(from, issuanceID, amount); err != nil {
+			return "", err
+		}
+	}
+
+	tx := &transactions.Payment{
+		Amount: types.MPTCurrencyAmount{
+			Value:         strconv.Itoa(amount),
+			MPTIssuanceID: issuanceID,
+		},
+		Destination: types.Address(to),
+	}
+
+	return b.SubmitMultisignedTx(from, signers, tx)
+}
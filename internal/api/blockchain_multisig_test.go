@@ -0,0 +1,160 @@
+package api
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+// multisigHandlers extends submitHandlers with a creditor account whose signer list holds
+// the given signers with weight 1 each, an MPT balance of 1 and a submit_multisigned method.
+func multisigHandlers(creditor string, quorum int, signers ...*wallet.Wallet) map[string]rpcHandler {
+	handlers := submitHandlers("tesSUCCESS")
+
+	entries := make([]any, len(signers))
+	for i, signer := range signers {
+		entries[i] = map[string]any{
+			"SignerEntry": map[string]any{"Account": signer.ClassicAddress.String(), "SignerWeight": 1},
+		}
+	}
+	accountInfo := handlers["account_info"]
+	handlers["account_info"] = func(params map[string]any) map[string]any {
+		result := accountInfo(params)
+		if params["account"] == creditor && params["signer_lists"] == true {
+			result["signer_lists"] = []any{map[string]any{
+				"LedgerEntryType": "SignerList",
+				"SignerEntries":   entries,
+				"SignerListID":    0,
+				"SignerQuorum":    quorum,
+			}}
+		}
+		return result
+	}
+	handlers["ledger_entry"] = func(params map[string]any) map[string]any {
+		return map[string]any{
+			"index": "B2C3E7A1B2C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E",
+			"node": map[string]any{
+				"Account":         creditor,
+				"Flags":           0,
+				"LedgerEntryType": "MPToken",
+				"MPTAmount":       "1",
+			},
+			"validated": true,
+		}
+	}
+	handlers["submit_multisigned"] = func(params map[string]any) map[string]any {
+		tx, _ := params["tx_json"].(map[string]any)
+		tx["hash"] = "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB74A9"
+		return map[string]any{
+			"engine_result":         "tesSUCCESS",
+			"engine_result_code":    0,
+			"engine_result_message": "The transaction was applied.",
+			"tx_json":               tx,
+		}
+	}
+	return handlers
+}
+
+// testSigners derives n signer wallets from testHexSeed, starting at index 10.
+func testSigners(t *testing.T, n int) []*wallet.Wallet {
+	t.Helper()
+	signers := make([]*wallet.Wallet, n)
+	for i := range signers {
+		w, err := crypto.NewWalletFromHexSeed(testHexSeed, fmt.Sprintf("m/44'/144'/0'/0/%d", 10+i))
+		assert.NoError(t, err)
+		signers[i] = w
+	}
+	return signers
+}
+
+func TestBlockchain_TransferMPTokenMultisig(t *testing.T) {
+	creditor, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	signers := testSigners(t, 2)
+	bc, node := newTestBlockchain(t, multisigHandlers(creditor.ClassicAddress.String(), 2, signers...))
+
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+
+	txHash, err := bc.TransferMPTokenMultisig(creditor.ClassicAddress.String(), signers, tokenID, testAddress)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, txHash)
+	assert.Equal(t, 0, node.Calls("submit"))
+
+	params := node.Params("submit_multisigned")
+	if !assert.Len(t, params, 1) {
+		return
+	}
+	tx := params[0]["tx_json"].(map[string]any)
+	assert.Equal(t, creditor.ClassicAddress.String(), tx["Account"])
+	assert.Equal(t, "", tx["SigningPubKey"])
+	// One base fee for the transaction and one per signer
+	assert.Equal(t, strconv.Itoa(stubBaseFee*3), tx["Fee"])
+
+	txSigners := tx["Signers"].([]any)
+	if !assert.Len(t, txSigners, 2) {
+		return
+	}
+	// JSON decodes the UInt32 fields as float64, the codec expects uint32
+	unsigned := make(map[string]any, len(tx))
+	for k, v := range tx {
+		switch k {
+		case "Signers", "hash":
+		case "Flags", "Sequence", "LastLedgerSequence":
+			unsigned[k] = uint32(v.(float64))
+		default:
+			unsigned[k] = v
+		}
+	}
+	var accountIDs []string
+	for _, s := range txSigners {
+		signer := s.(map[string]any)["Signer"].(map[string]any)
+		account := signer["Account"].(string)
+		encoded, err := binarycodec.EncodeForMultisigning(unsigned, account)
+		assert.NoError(t, err)
+		message, err := hex.DecodeString(encoded)
+		assert.NoError(t, err)
+		ok, err := keypairs.Validate(string(message), signer["SigningPubKey"].(string), signer["TxnSignature"].(string))
+		assert.NoError(t, err)
+		assert.True(t, ok, "signature of %s", account)
+
+		_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(account)
+		assert.NoError(t, err)
+		accountIDs = append(accountIDs, hex.EncodeToString(accountID))
+	}
+	assert.True(t, accountIDs[0] < accountIDs[1], "signers must be sorted by account ID")
+}
+
+func TestBlockchain_TransferMPTokenMultisig_QuorumNotMet(t *testing.T) {
+	creditor, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	signers := testSigners(t, 3)
+	bc, node := newTestBlockchain(t, multisigHandlers(creditor.ClassicAddress.String(), 2, signers[:2]...))
+
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+	from := creditor.ClassicAddress.String()
+
+	_, err = bc.TransferMPTokenMultisig(from, signers[:1], tokenID, testAddress)
+	assert.True(t, errors.Is(err, ErrQuorumNotMet), "got %v", err)
+
+	_, err = bc.TransferMPTokenMultisig(from, []*wallet.Wallet{signers[0], signers[0]}, tokenID, testAddress)
+	assert.Error(t, err)
+
+	_, err = bc.TransferMPTokenMultisig(from, []*wallet.Wallet{signers[0], signers[2]}, tokenID, testAddress)
+	assert.True(t, errors.Is(err, ErrSignerNotListed), "got %v", err)
+
+	_, err = bc.TransferMPTokenMultisig(testAddress, signers[:2], tokenID, from, WithoutBalanceCheck())
+	assert.True(t, errors.Is(err, ErrNoSignerList), "got %v", err)
+
+	assert.Equal(t, 0, node.Calls("submit_multisigned"))
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+const (
+	// nftokenIDLength is the length of an NFTokenID in bytes: its flags, transfer fee,
+	// issuer, scrambled taxon and sequence.
+	nftokenIDLength = 32
+	// lsfBurnable is the NFToken flag allowing its issuer to burn it.
+	lsfBurnable uint16 = 0x0001
+)
+
+var (
+	// ErrInvalidNFTokenID is returned when an NFTokenID is not a 32-byte hex string.
+	ErrInvalidNFTokenID = errors.New("invalid NFTokenID")
+	// ErrNotAuthorizedBurner is returned when an account may not burn an NFToken: it is
+	// neither its owner nor the issuer of a burnable token.
+	ErrNotAuthorizedBurner = errors.New("account may not burn the NFToken")
+)
+
+// nftMintOptions holds the optional fields of MintWarrantNFT.
+type nftMintOptions struct {
+	transferFee *uint16
+}
+
+// NFTMintOption configures MintWarrantNFT.
+type NFTMintOption func(*nftMintOptions)
+
+// WithNFTTransferFee sets the fee the issuer charges on secondary sales of the NFToken,
+// in units of 0.001%, up to 50000. A fee requires the token to be transferable.
+//
+// Parameters:
+// - fee: The transfer fee
+func WithNFTTransferFee(fee uint16) NFTMintOption {
+	return func(o *nftMintOptions) {
+		o.transferFee = &fee
+	}
+}
+
+// NFTSummary is an NFToken held by an account.
+type NFTSummary struct {
+	NFTokenID string
+	Issuer    string
+	Taxon     uint32
+	// URI is the URI of the token decoded from its hex encoding, empty if it has none
+	URI string
+}
+
+// ListNFTs returns every NFToken the account holds on the latest validated ledger, paging
+// through its account NFTs. Warrants are MPTs, but some legacy assets are NFTs that
+// reconciliation has to account for.
+//
+// Parameters:
+// - address: The account address whose NFTokens are listed
+//
+// Returns the tokens in ledger order, or an error if they cannot be read or a URI is not hex.
+func (b *Blockchain) ListNFTs(address string) ([]NFTSummary, error) {
+	var nfts []NFTSummary
+	var marker any
+	for {
+		resp, err := b.c.GetAccountNFTs(&account.NFTsRequest{
+			Account:     types.Address(address),
+			LedgerIndex: common.Validated,
+			Marker:      marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account NFTs: %w", err)
+		}
+
+		for _, nft := range resp.AccountNFTs {
+			uri, err := hex.DecodeString(string(nft.URI))
+			if err != nil {
+				return nil, fmt.Errorf("invalid URI of NFToken %s: %w", nft.NFTokenID, err)
+			}
+			nfts = append(nfts, NFTSummary{
+				NFTokenID: string(nft.NFTokenID),
+				Issuer:    string(nft.Issuer),
+				Taxon:     uint32(nft.NFTokenTaxon),
+				URI:       string(uri),
+			})
+		}
+		if resp.Marker == nil {
+			return nfts, nil
+		}
+		marker = resp.Marker
+	}
+}
+
+// MintWarrantNFT mints a warrant as a one-of-a-kind NFToken instead of an MPT, as some
+// jurisdictions require, and waits until it is validated to read the minted token's ID.
+// The token is always minted burnable, so the issuer can destroy it with BurnWarrantNFT on
+// redemption wherever it is held. The transaction is checked with NFTokenMint's own
+// validation before it is submitted.
+//
+// Parameters:
+// - w: The wallet of the issuer minting the token
+// - uri: The hex encoded URI of the warrant document or metadata
+// - taxon: The taxon grouping the issuer's warrants
+// - transferable: Whether holders may transfer the token to other accounts than the issuer
+// - opts: The optional fields, such as WithNFTTransferFee
+//
+// Returns the transaction hash and the NFTokenID, an error if the transaction is invalid,
+// such as a non-hex URI or a transfer fee on a non-transferable token, or an error if the
+// mint fails or its metadata carries no NFTokenID.
+func (b *Blockchain) MintWarrantNFT(w *wallet.Wallet, uri string, taxon uint32, transferable bool, opts ...NFTMintOption) (
+	hash, nftID string, err error) {
+	if w == nil {
+		return "", "", fmt.Errorf("wallet cannot be nil")
+	}
+	var o nftMintOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	tx := &transactions.NFTokenMint{
+		BaseTx: transactions.BaseTx{
+			Account:         w.ClassicAddress,
+			TransactionType: transactions.NFTokenMintTx,
+		},
+		NFTokenTaxon: taxon,
+		TransferFee:  o.transferFee,
+		URI:          types.NFTokenURI(uri),
+	}
+	tx.SetBurnableFlag()
+	if transferable {
+		tx.SetTransferableFlag()
+	}
+	if _, err := tx.Validate(); err != nil {
+		return "", "", fmt.Errorf("invalid NFTokenMint: %w", err)
+	}
+
+	resp, err := b.submitAndWait(w, nftokenMint{tx})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to submit tx: %w", err)
+	}
+	meta, _ := resp.Meta.(map[string]any)
+	nftID, _ = meta["nftoken_id"].(string)
+	if nftID == "" {
+		return string(resp.Hash), "", fmt.Errorf("transaction %s: metadata has no nftoken_id", resp.Hash)
+	}
+	return string(resp.Hash), nftID, nil
+}
+
+// BurnWarrantNFT destroys an NFToken warrant on redemption. The owner can always burn its
+// token; the issuer can burn it from the owner's account only if it was minted burnable.
+// Any other account is rejected before submitting, as the ledger would reject it.
+//
+// Parameters:
+// - w: The wallet of the burning account, the owner or the issuer
+// - nftID: The NFTokenID of the warrant
+// - owner: The account address holding the token
+//
+// Returns the transaction hash, an error wrapping ErrInvalidNFTokenID if the ID is
+// malformed, an error wrapping ErrNotAuthorizedBurner if the account may not burn the
+// token, or an error if the burn fails.
+func (b *Blockchain) BurnWarrantNFT(w *wallet.Wallet, nftID string, owner string) (string, error) {
+	if w == nil {
+		return "", fmt.Errorf("wallet cannot be nil")
+	}
+	if !addresscodec.IsValidClassicAddress(owner) {
+		return "", fmt.Errorf("%w: owner %q", ErrInvalidAddress, owner)
+	}
+	issuer, flags, err := DecodeNFTokenID(nftID)
+	if err != nil {
+		return "", err
+	}
+
+	burner := w.ClassicAddress.String()
+	tx := &transactions.NFTokenBurn{NFTokenID: types.NFTokenID(nftID)}
+	switch {
+	case burner == owner:
+	case burner == issuer && flags&lsfBurnable != 0:
+		tx.Owner = types.Address(owner)
+	case burner == issuer:
+		return "", fmt.Errorf("%w: %s was not minted burnable", ErrNotAuthorizedBurner, nftID)
+	default:
+		return "", fmt.Errorf("%w: %s is neither the owner nor the issuer", ErrNotAuthorizedBurner, burner)
+	}
+
+	return b.SubmitTx(w, tx)
+}
+
+// DecodeNFTokenID extracts the issuer and the flags from an NFTokenID.
+//
+// Parameters:
+// - id: The NFTokenID, 64 hex characters
+//
+// Returns the issuer address and the token flags, or an error wrapping ErrInvalidNFTokenID.
+func DecodeNFTokenID(id string) (issuer string, flags uint16, err error) {
+	bytes, err := hex.DecodeString(id)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %v", ErrInvalidNFTokenID, err)
+	}
+	if len(bytes) != nftokenIDLength {
+		return "", 0, fmt.Errorf("%w: %d bytes, expected %d", ErrInvalidNFTokenID, len(bytes), nftokenIDLength)
+	}
+
+	issuer, err = addresscodec.EncodeAccountIDToClassicAddress(bytes[4:24])
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %v", ErrInvalidNFTokenID, err)
+	}
+	return issuer, binary.BigEndian.Uint16(bytes[:2]), nil
+}
+
+// nftokenMint flattens an NFTokenMint with its TransferFee as an int, the type the binary
+// codec encodes UInt16 fields from; the client flattens it as a uint16, which the codec
+// cannot encode.
+type nftokenMint struct {
+	*transactions.NFTokenMint
+}
+
+func (m nftokenMint) Flatten() transactions.FlatTransaction {
+	flattened := m.NFTokenMint.Flatten()
+	if fee, ok := flattened["TransferFee"].(uint16); ok {
+		flattened["TransferFee"] = int(fee)
+	}
+	return flattened
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	// accountNFTsFixture is the first page of an account_nfts result, holding a token
+	// with a hex encoded IPFS URI and a token without URI.
+	accountNFTsFixture = `{
+		"account": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+		"account_nfts": [
+			{
+				"Flags": 8,
+				"Issuer": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"NFTokenID": "00080000B4F4AFC5FBCBD76873F18006173D2193467D3EE70000099B00000000",
+				"NFTokenTaxon": 7,
+				"URI": "697066733A2F2F62616679626569676479727A74357366703775646D37687537367568377932366E6634646675796C71616266336F636C67747179353566627A6469",
+				"nft_serial": 0
+			},
+			{
+				"Flags": 8,
+				"Issuer": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+				"NFTokenID": "00080000B4F4AFC5FBCBD76873F18006173D2193467D3EE70000099B00000001",
+				"NFTokenTaxon": 7,
+				"nft_serial": 1
+			}
+		],
+		"ledger_index": 200,
+		"limit": 2,
+		"marker": "page2",
+		"validated": true
+	}`
+	// accountNFTsPage2Fixture is the last page of the account_nfts result.
+	accountNFTsPage2Fixture = `{
+		"account": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+		"account_nfts": [
+			{
+				"Flags": 0,
+				"Issuer": "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE",
+				"NFTokenID": "000000000F4E8B8DA9F1A2C0E1D24DC8B1B7F5A8CAF4B7D60000000000000000",
+				"NFTokenTaxon": 0,
+				"URI": "68747470733A2F2F666F727473746F636B2E696F2F6E66742F31",
+				"nft_serial": 0
+			}
+		],
+		"ledger_index": 200,
+		"validated": true
+	}`
+)
+
+func TestBlockchain_ListNFTs(t *testing.T) {
+	first, second := fixtureResult(accountNFTsFixture), fixtureResult(accountNFTsPage2Fixture)
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"account_nfts": func(params map[string]any) map[string]any {
+			if params["marker"] == "page2" {
+				return second(params)
+			}
+			return first(params)
+		},
+	})
+
+	nfts, err := bc.ListNFTs(testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, node.Calls("account_nfts"))
+	if assert.Len(t, nfts, 3) {
+		assert.Equal(t, NFTSummary{
+			NFTokenID: "00080000B4F4AFC5FBCBD76873F18006173D2193467D3EE70000099B00000000",
+			Issuer:    "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+			Taxon:     7,
+			URI:       "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf4dfuylqabf3oclgtqy55fbzdi",
+		}, nfts[0])
+		assert.Empty(t, nfts[1].URI)
+		assert.Equal(t, "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE", nfts[2].Issuer)
+		assert.Equal(t, "https://fortstock.io/nft/1", nfts[2].URI)
+	}
+
+	bc, _ = newTestBlockchain(t, map[string]rpcHandler{
+		"account_nfts": func(params map[string]any) map[string]any {
+			return map[string]any{"account_nfts": []any{map[string]any{"NFTokenID": "00", "URI": "not hex"}}}
+		},
+	})
+	_, err = bc.ListNFTs(testAddress)
+	assert.Error(t, err)
+}
+
+func TestBlockchain_MintWarrantNFT(t *testing.T) {
+	const nftID = "00080000B4F4AFC5FBCBD76873F18006173D2193467D3EE70000099B00000000"
+	const uri = "68747470733A2F2F666F727473746F636B2E696F2F6E66742F31"
+
+	handlers := submitHandlers("tesSUCCESS")
+	tx := handlers["tx"]
+	handlers["tx"] = func(params map[string]any) map[string]any {
+		result := tx(params)
+		result["meta"].(map[string]any)["nftoken_id"] = nftID
+		return result
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	hash, id, err := bc.MintWarrantNFT(bc.w, uri, 7, true, WithNFTTransferFee(500))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, nftID, id)
+
+	mint := submittedTx(t, node, 0)
+	assert.Equal(t, "NFTokenMint", mint["TransactionType"])
+	assert.Equal(t, uint32(7), mint["NFTokenTaxon"])
+	assert.Equal(t, uri, mint["URI"])
+	assert.EqualValues(t, 500, mint["TransferFee"])
+	assert.Equal(t, uint32(9), mint["Flags"], "tfBurnable and tfTransferable")
+}
+
+func TestBlockchain_MintWarrantNFT_IssuerBurns(t *testing.T) {
+	const holder = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+
+	// The ledger carries the mint flags into the NFTokenID of the minted token
+	var nftID string
+	handlers := submitHandlers("tesSUCCESS")
+	submit, tx := handlers["submit"], handlers["tx"]
+	handlers["submit"] = func(params map[string]any) map[string]any {
+		mint, err := binarycodec.Decode(params["tx_blob"].(string))
+		if err == nil && mint["TransactionType"] == "NFTokenMint" {
+			nftID = testNFTokenID(t, uint16(mint["Flags"].(uint32)), testAddress)
+		}
+		return submit(params)
+	}
+	handlers["tx"] = func(params map[string]any) map[string]any {
+		result := tx(params)
+		result["meta"].(map[string]any)["nftoken_id"] = nftID
+		return result
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	_, id, err := bc.MintWarrantNFT(bc.w, "", 7, false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), submittedTx(t, node, 0)["Flags"], "tfBurnable")
+
+	// Once the token is held elsewhere, the issuer burns it on redemption
+	_, err = bc.BurnWarrantNFT(bc.w, id, holder)
+	assert.NoError(t, err)
+	burn := submittedTx(t, node, 1)
+	assert.Equal(t, "NFTokenBurn", burn["TransactionType"])
+	assert.Equal(t, id, burn["NFTokenID"])
+	assert.Equal(t, holder, burn["Owner"])
+}
+
+func TestBlockchain_MintWarrantNFT_Invalid(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// A transfer fee is only allowed on a transferable token
+	_, _, err := bc.MintWarrantNFT(bc.w, "", 7, false, WithNFTTransferFee(500))
+	assert.True(t, errors.Is(err, transaction.ErrTransferFeeRequiresTransferableFlag), "got %v", err)
+
+	_, _, err = bc.MintWarrantNFT(bc.w, "https://fortstock.io/nft/1", 7, true)
+	assert.True(t, errors.Is(err, transaction.ErrInvalidURI), "got %v", err)
+
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+// testNFTokenID builds the NFTokenID of a token with the given flags minted by the issuer.
+func testNFTokenID(t *testing.T, flags uint16, issuer string) string {
+	t.Helper()
+	_, accountID, err := addresscodec.DecodeClassicAddressToAccountID(issuer)
+	if err != nil {
+		t.Fatalf("failed to decode issuer: %v", err)
+	}
+	return fmt.Sprintf("%04X0000%s%016X", flags, strings.ToUpper(hex.EncodeToString(accountID)), 42)
+}
+
+func TestDecodeNFTokenID(t *testing.T) {
+	issuer, flags, err := DecodeNFTokenID(testNFTokenID(t, 9, testAddress))
+	assert.NoError(t, err)
+	assert.Equal(t, testAddress, issuer)
+	assert.Equal(t, uint16(9), flags)
+
+	for _, id := range []string{"", "00", "zz" + testNFTokenID(t, 9, testAddress)[2:]} {
+		_, _, err = DecodeNFTokenID(id)
+		assert.True(t, errors.Is(err, ErrInvalidNFTokenID), "id %q: got %v", id, err)
+	}
+}
+
+func TestBlockchain_BurnWarrantNFT(t *testing.T) {
+	const holder = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// The owner burns its own token
+	ownID := testNFTokenID(t, 8, holder)
+	hash, err := bc.BurnWarrantNFT(bc.w, ownID, testAddress)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	burn := submittedTx(t, node, 0)
+	assert.Equal(t, "NFTokenBurn", burn["TransactionType"])
+	assert.Equal(t, ownID, burn["NFTokenID"])
+	assert.NotContains(t, burn, "Owner")
+
+	// The issuer burns a burnable token from the holder's account
+	burnableID := testNFTokenID(t, 9, testAddress)
+	_, err = bc.BurnWarrantNFT(bc.w, burnableID, holder)
+	assert.NoError(t, err)
+	assert.Equal(t, holder, submittedTx(t, node, 1)["Owner"])
+}
+
+func TestBlockchain_BurnWarrantNFT_Unauthorized(t *testing.T) {
+	const holder = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// Neither the owner nor the issuer
+	_, err := bc.BurnWarrantNFT(bc.w, testNFTokenID(t, 9, "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"), holder)
+	assert.True(t, errors.Is(err, ErrNotAuthorizedBurner), "got %v", err)
+
+	// The issuer of a token that was not minted burnable
+	_, err = bc.BurnWarrantNFT(bc.w, testNFTokenID(t, 8, testAddress), holder)
+	assert.True(t, errors.Is(err, ErrNotAuthorizedBurner), "got %v", err)
+
+	_, err = bc.BurnWarrantNFT(bc.w, "00", holder)
+	assert.True(t, errors.Is(err, ErrInvalidNFTokenID), "got %v", err)
+	_, err = bc.BurnWarrantNFT(bc.w, testNFTokenID(t, 9, testAddress), "rInvalid")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+
+	assert.Equal(t, 0, node.TotalCalls())
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+var (
+	// ErrIncompatibleOfferFlags is returned when an offer requests flags that cannot work together.
+	ErrIncompatibleOfferFlags = errors.New("incompatible offer flags")
+)
+
+// OfferFlags selects how an offer trades on the DEX.
+type OfferFlags struct {
+	// Passive does not consume offers that exactly match it, only offers that cross it.
+	Passive bool
+	// ImmediateOrCancel trades what it can at once and never rests in the order book.
+	ImmediateOrCancel bool
+	// FillOrKill trades in full at once or not at all, failing with tecKILLED.
+	FillOrKill bool
+	// Sell exchanges the whole TakerGets amount, even for more than TakerPays.
+	Sell bool
+}
+
+// Validate checks the flags can be combined. An offer is either immediate-or-cancel
+// or fill-or-kill, the node rejects both with temINVALID_FLAG.
+//
+// Returns an error wrapping ErrIncompatibleOfferFlags if they cannot.
+func (f OfferFlags) Validate() error {
+	if f.ImmediateOrCancel && f.FillOrKill {
+		return fmt.Errorf("%w: ImmediateOrCancel and FillOrKill are exclusive", ErrIncompatibleOfferFlags)
+	}
+	return nil
+}
+
+// applyTo sets the selected flags on the offer transaction.
+func (f OfferFlags) applyTo(tx *transaction.OfferCreate) {
+	if f.Passive {
+		tx.SetPassiveFlag()
+	}
+	if f.ImmediateOrCancel {
+		tx.SetImmediateOrCancelFlag()
+	}
+	if f.FillOrKill {
+		tx.SetFillOrKillFlag()
+	}
+	if f.Sell {
+		tx.SetSellFlag()
+	}
+}
+
+// CreateOffer places an offer on the DEX to exchange takerGets for takerPays,
+// giving warrant holders a secondary market. An offer that is not filled at once
+// rests in the order book until it is filled or cancelled with CancelOffer.
+//
+// Parameters:
+// - w: The wallet of the account placing the offer
+// - takerGets: The amount the account sells
+// - takerPays: The amount the account buys
+// - flags: How the offer trades, see OfferFlags
+//
+// Returns the transaction hash and its sequence, which identifies the offer when cancelling it,
+// or an error if validation or submission fails. A fill-or-kill offer that cannot be filled
+// fails with an EngineError of tecKILLED.
+func (b *Blockchain) CreateOffer(w *wallet.Wallet, takerGets, takerPays types.CurrencyAmount, flags OfferFlags) (
+	txHash string, offerSequence uint32, err error) {
+	if w == nil {
+		return "", 0, fmt.Errorf("wallet cannot be nil")
+	}
+	if err := validatePositiveAmount(takerGets, "TakerGets"); err != nil {
+		return "", 0, err
+	}
+	if err := validatePositiveAmount(takerPays, "TakerPays"); err != nil {
+		return "", 0, err
+	}
+	if err := flags.Validate(); err != nil {
+		return "", 0, err
+	}
+
+	tx := &transaction.OfferCreate{
+		TakerGets: takerGets,
+		TakerPays: takerPays,
+	}
+	flags.applyTo(tx)
+
+	txHash, offerSequence, err = b.submitForSequence(w, tx)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to submit tx: %w", err)
+	}
+	return txHash, offerSequence, nil
+}
+
+// CancelOffer removes an offer of the account from the DEX. Cancelling an offer
+// that was already filled or cancelled is not an error.
+//
+// Parameters:
+// - w: The wallet of the account that placed the offer
+// - offerSequence: The sequence of the OfferCreate transaction
+//
+// Returns the transaction hash if successful, or an error if submission fails.
+func (b *Blockchain) CancelOffer(w *wallet.Wallet, offerSequence uint32) (txHash string, err error) {
+	if w == nil {
+		return "", fmt.Errorf("wallet cannot be nil")
+	}
+	if offerSequence == 0 {
+		return "", fmt.Errorf("offer sequence is required")
+	}
+
+	return b.SubmitTx(w, &transaction.OfferCancel{OfferSequence: offerSequence})
+}
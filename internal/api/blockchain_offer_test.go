@@ -0,0 +1,65 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// rlusdAmount returns an RLUSD amount issued by the test address.
+func rlusdAmount(value string) types.IssuedCurrencyAmount {
+	return types.IssuedCurrencyAmount{Currency: RLUSDHex, Issuer: types.Address(testAddress), Value: value}
+}
+
+func TestBlockchain_CreateOffer(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	hash, sequence, err := bc.CreateOffer(bc.w, types.XRPCurrencyAmount(1000000), rlusdAmount("2"),
+		OfferFlags{ImmediateOrCancel: true})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "OfferCreate", tx["TransactionType"])
+	assert.Equal(t, "1000000", tx["TakerGets"])
+	assert.Equal(t, uint32(131072), tx["Flags"])
+	assert.Equal(t, tx["Sequence"], sequence)
+
+	_, err = bc.CancelOffer(bc.w, sequence)
+	assert.NoError(t, err)
+
+	tx = submittedTx(t, node, 1)
+	assert.Equal(t, "OfferCancel", tx["TransactionType"])
+	assert.Equal(t, sequence, tx["OfferSequence"])
+}
+
+func TestBlockchain_CreateOffer_FillOrKillKilled(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers(string(transaction.TecKILLED)))
+
+	_, _, err := bc.CreateOffer(bc.w, rlusdAmount("2"), types.XRPCurrencyAmount(1000000),
+		OfferFlags{FillOrKill: true})
+	assert.True(t, errors.Is(err, &EngineError{Result: transaction.TecKILLED}), "got %v", err)
+	assert.Equal(t, uint32(262144), submittedTx(t, node, 0)["Flags"])
+}
+
+func TestBlockchain_CreateOffer_Invalid(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	_, _, err := bc.CreateOffer(bc.w, types.XRPCurrencyAmount(0), rlusdAmount("2"), OfferFlags{})
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	_, _, err = bc.CreateOffer(bc.w, types.XRPCurrencyAmount(1000000), nil, OfferFlags{})
+	assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+	_, _, err = bc.CreateOffer(bc.w, types.XRPCurrencyAmount(1000000), rlusdAmount("2"),
+		OfferFlags{ImmediateOrCancel: true, FillOrKill: true})
+	assert.True(t, errors.Is(err, ErrIncompatibleOfferFlags))
+
+	_, err = bc.CancelOffer(bc.w, 0)
+	assert.Error(t, err)
+
+	assert.Equal(t, 0, node.TotalCalls())
+}
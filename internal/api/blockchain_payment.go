@@ -0,0 +1,130 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+var (
+	// ErrPartialPaymentXRP mirrors temBAD_SEND_XRP_PARTIAL: a partial payment cannot be a direct XRP-to-XRP payment.
+	ErrPartialPaymentXRP = errors.New(string(transaction.TemBAD_SEND_XRP_PARTIAL) + ": partial payment cannot be XRP to XRP")
+	// ErrSendMaxXRP mirrors temBAD_SEND_XRP_MAX: SendMax cannot be set on a direct XRP-to-XRP payment.
+	ErrSendMaxXRP = errors.New(string(transaction.TemBAD_SEND_XRP_MAX) + ": SendMax cannot be set for XRP to XRP")
+	// ErrDeliverMinWithoutPartial is returned when DeliverMin is set without the partial payment flag.
+	ErrDeliverMinWithoutPartial = errors.New(string(transaction.TemBAD_AMOUNT) + ": DeliverMin requires a partial payment")
+	// ErrDeliverMinAsset mirrors temBAD_AMOUNT: DeliverMin must be in the asset of DeliverMax.
+	ErrDeliverMinAsset = errors.New(string(transaction.TemBAD_AMOUNT) + ": DeliverMin must be in the currency of DeliverMax")
+	// ErrDeliverMinExceedsMax mirrors temBAD_AMOUNT: DeliverMin cannot exceed DeliverMax.
+	ErrDeliverMinExceedsMax = errors.New(string(transaction.TemBAD_AMOUNT) + ": DeliverMin cannot exceed DeliverMax")
+	// ErrAmountDeliverMaxMismatch is returned when Amount and DeliverMax are both set and differ.
+	ErrAmountDeliverMaxMismatch = errors.New("payment transaction: Amount and DeliverMax fields must be identical when both are provided")
+)
+
+// checkPaymentAmounts reconciles Amount and DeliverMax the same way the rpc client does during autofill:
+// DeliverMax fills a missing Amount, and both must be identical when both are provided.
+// DeliverMax is not a binary field, so only the resulting Amount is kept on the transaction.
+func checkPaymentAmounts(amount, deliverMax types.CurrencyAmount) (types.CurrencyAmount, error) {
+	if deliverMax == nil {
+		return amount, nil
+	}
+	if amount == nil {
+		return deliverMax, nil
+	}
+	if !reflect.DeepEqual(amount.Flatten(), deliverMax.Flatten()) {
+		return nil, ErrAmountDeliverMaxMismatch
+	}
+	return amount, nil
+}
+
+// checkDeliverMin checks DeliverMin is a positive amount of the DeliverMax asset that does not
+// exceed DeliverMax, as the node does before applying a partial payment.
+func checkDeliverMin(deliverMin, deliverMax types.CurrencyAmount) error {
+	if deliverMin == nil {
+		return nil
+	}
+	if !sameAsset(deliverMin, deliverMax) {
+		return ErrDeliverMinAsset
+	}
+	if err := validatePositiveAmount(deliverMin, "DeliverMin"); err != nil {
+		return err
+	}
+
+	minValue, err := amountValue(deliverMin)
+	if err != nil {
+		return fmt.Errorf("%w: DeliverMin: %v", ErrInvalidAmount, err)
+	}
+	maxValue, err := amountValue(deliverMax)
+	if err != nil {
+		return fmt.Errorf("%w: DeliverMax: %v", ErrInvalidAmount, err)
+	}
+	if minValue.GreaterThan(maxValue) {
+		return fmt.Errorf("%w: %s > %s", ErrDeliverMinExceedsMax, minValue, maxValue)
+	}
+	return nil
+}
+
+// PaymentWithPaths executes a cross-currency payment, optionally as a partial payment.
+// For partial payments the amount actually delivered may be less than deliverMax,
+// but not less than deliverMin, which must be in the same asset and cannot exceed deliverMax.
+//
+// Parameters:
+// - from: The source wallet
+// - to: The destination account address
+// - deliverMax: The maximum amount to deliver to the destination (becomes Amount)
+// - sendMax: The maximum amount the source is willing to spend, nil for direct payments
+// - deliverMin: The minimum amount to deliver for partial payments, may be nil
+// - partial: Whether to set the tfPartialPayment flag
+//
+// Returns the transaction hash if successful, or an error if validation or submission fails.
+func (b *Blockchain) PaymentWithPaths(from *wallet.Wallet, to types.Address,
+	deliverMax, sendMax, deliverMin types.CurrencyAmount, partial bool) (txHash string, err error) {
+	amount, err := checkPaymentAmounts(nil, deliverMax)
+	if err != nil {
+		return "", err
+	}
+	if ok, err := transaction.IsAmount(amount, "DeliverMax", true); !ok {
+		return "", err
+	}
+	if ok, err := transaction.IsAmount(sendMax, "SendMax", false); !ok {
+		return "", err
+	}
+	if ok, err := transaction.IsAmount(deliverMin, "DeliverMin", false); !ok {
+		return "", err
+	}
+
+	xrpToXRP := amount.Kind() == types.XRP && (sendMax == nil || sendMax.Kind() == types.XRP)
+	if xrpToXRP && partial {
+		return "", ErrPartialPaymentXRP
+	}
+	if xrpToXRP && sendMax != nil {
+		return "", ErrSendMaxXRP
+	}
+	if deliverMin != nil && !partial {
+		return "", ErrDeliverMinWithoutPartial
+	}
+	if err := checkDeliverMin(deliverMin, amount); err != nil {
+		return "", err
+	}
+
+	payment := &transaction.Payment{
+		Amount:      amount,
+		Destination: to,
+		SendMax:     sendMax,
+		DeliverMin:  deliverMin,
+	}
+	if partial {
+		payment.SetPartialPaymentFlag()
+	}
+
+	txHash, err = b.SubmitTx(from, payment)
+	if err != nil {
+		return "", fmt.Errorf("failed to submit payment: %w", err)
+	}
+
+	return txHash, nil
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_PaymentWithPaths_PartialXRPRejected(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	hash, err := bc.PaymentWithPaths(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		types.XRPCurrencyAmount(1000), nil, types.XRPCurrencyAmount(500), true)
+	assert.Empty(t, hash)
+	assert.True(t, errors.Is(err, ErrPartialPaymentXRP))
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_PaymentWithPaths_Partial(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	deliver := types.IssuedCurrencyAmount{Issuer: bc.w.ClassicAddress, Currency: RLUSDHex, Value: "100"}
+	deliverMin := types.IssuedCurrencyAmount{Issuer: bc.w.ClassicAddress, Currency: RLUSDHex, Value: "90"}
+
+	hash, err := bc.PaymentWithPaths(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		deliver, types.XRPCurrencyAmount(5000000), deliverMin, true)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "90", tx["DeliverMin"].(map[string]any)["value"])
+	assert.Equal(t, "100", tx["Amount"].(map[string]any)["value"])
+	assert.Equal(t, "5000000", tx["SendMax"])
+	assert.NotZero(t, tx["Flags"].(uint32)&0x00020000)
+}
+
+func TestBlockchain_PaymentWithPaths_DeliverMinWithoutPartial(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	deliver := types.IssuedCurrencyAmount{Issuer: bc.w.ClassicAddress, Currency: RLUSDHex, Value: "100"}
+	_, err := bc.PaymentWithPaths(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		deliver, nil, deliver, false)
+	assert.True(t, errors.Is(err, ErrDeliverMinWithoutPartial))
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestCheckPaymentAmounts(t *testing.T) {
+	a := types.IssuedCurrencyAmount{Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", Currency: "USD", Value: "1"}
+	b := types.IssuedCurrencyAmount{Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", Currency: "USD", Value: "2"}
+
+	got, err := checkPaymentAmounts(nil, a)
+	assert.NoError(t, err)
+	assert.Equal(t, a, got)
+
+	got, err = checkPaymentAmounts(a, a)
+	assert.NoError(t, err)
+	assert.Equal(t, a, got)
+
+	_, err = checkPaymentAmounts(a, b)
+	assert.True(t, errors.Is(err, ErrAmountDeliverMaxMismatch))
+}
+
+func TestBlockchain_PaymentWithPaths_DeliverMinExceedsMax(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	deliver := types.IssuedCurrencyAmount{Issuer: bc.w.ClassicAddress, Currency: RLUSDHex, Value: "100"}
+	deliverMin := types.IssuedCurrencyAmount{Issuer: bc.w.ClassicAddress, Currency: RLUSDHex, Value: "100.5"}
+	_, err := bc.PaymentWithPaths(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		deliver, types.XRPCurrencyAmount(5000000), deliverMin, true)
+	assert.True(t, errors.Is(err, ErrDeliverMinExceedsMax), "got %v", err)
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestCheckDeliverMin(t *testing.T) {
+	deliverMax := types.IssuedCurrencyAmount{Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", Currency: "USD", Value: "100"}
+
+	tests := []struct {
+		name       string
+		deliverMin types.CurrencyAmount
+		want       error
+	}{
+		{name: "nil", deliverMin: nil},
+		{name: "equal", deliverMin: deliverMax},
+		{name: "below", deliverMin: types.IssuedCurrencyAmount{Issuer: deliverMax.Issuer, Currency: "USD", Value: "99.99"}},
+		{name: "above", deliverMin: types.IssuedCurrencyAmount{Issuer: deliverMax.Issuer, Currency: "USD", Value: "100.01"}, want: ErrDeliverMinExceedsMax},
+		{name: "other currency", deliverMin: types.IssuedCurrencyAmount{Issuer: deliverMax.Issuer, Currency: "EUR", Value: "1"}, want: ErrDeliverMinAsset},
+		{name: "other issuer", deliverMin: types.IssuedCurrencyAmount{Issuer: types.Address(testAddress), Currency: "USD", Value: "1"}, want: ErrDeliverMinAsset},
+		{name: "XRP", deliverMin: types.XRPCurrencyAmount(1), want: ErrDeliverMinAsset},
+		{name: "zero", deliverMin: types.IssuedCurrencyAmount{Issuer: deliverMax.Issuer, Currency: "USD", Value: "0"}, want: ErrInvalidAmount},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkDeliverMin(tt.deliverMin, deliverMax)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.want), "got %v", err)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+var (
+	// ErrInvalidConcurrency is returned when the provisioning worker count is not positive.
+	ErrInvalidConcurrency = errors.New("concurrency must be positive")
+	// ErrReserveUnavailable is returned when the node reports no reserve requirements.
+	ErrReserveUnavailable = errors.New("reserve requirements unavailable")
+	// ErrInvalidAddress is returned when an account address is not a valid classic address.
+	ErrInvalidAddress = errors.New("invalid account address")
+	// ErrAccountUnusable is returned when provisioning an account that can no longer sign or hold RLUSD.
+	ErrAccountUnusable = errors.New("account is unusable")
+)
+
+// AccountSpec describes an account to provision from the system account.
+type AccountSpec struct {
+	// Wallet is the account to fund, it signs its own trustline.
+	Wallet *wallet.Wallet
+	// Drops is the XRP the account can spend above its reserve, such as on fees, in drops.
+	Drops uint64
+	// ExpectedOwnerCount is the number of ledger objects the account will own, each adding
+	// the owner reserve to its funding. The RLUSD trustline is counted when TrustlineLimit is set.
+	ExpectedOwnerCount uint32
+	// TrustlineLimit is the RLUSD trustline limit, zero skips the trustline setup.
+	TrustlineLimit float64
+}
+
+// ProvisionResult is the outcome of provisioning a single account.
+type ProvisionResult struct {
+	Address  string
+	FundHash string
+	Err      error
+}
+
+// GetReserveParams reads the account reserve requirements of the latest validated ledger
+// from the server state.
+//
+// Returns the base reserve of an account and the owner reserve of each object it owns, in drops,
+// or an error if the server state cannot be read or carries no validated ledger.
+func (b *Blockchain) GetReserveParams() (base, inc uint64, err error) {
+	resp, err := b.c.GetServerState(&server.StateRequest{})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get server state: %w", err)
+	}
+
+	ledger := resp.State.ValidatedLedger
+	if ledger.ReserveBase == 0 {
+		return 0, 0, fmt.Errorf("%w: no validated ledger", ErrReserveUnavailable)
+	}
+	return uint64(ledger.ReserveBase), uint64(ledger.ReserveInc), nil
+}
+
+// IsAccountUsable checks an account can sign transactions and hold the assets the service
+// needs. An account is unusable if it does not exist, if it is blackholed, that is its master
+// key is disabled with no regular key set, or if the RLUSD issuer, the system account, froze
+// its tokens globally.
+// A signer list could still sign for a blackholed account but is not taken into account,
+// as the service never signs with one.
+//
+// Parameters:
+// - address: The XRPL account address to check
+//
+// Returns whether the account is usable and, if not, the reason, an error wrapping
+// ErrInvalidAddress if the address is malformed, or an error if the ledger cannot be read.
+func (b *Blockchain) IsAccountUsable(address string) (usable bool, reason string, err error) {
+	return b.accountUsable(address, false)
+}
+
+// accountUsable implements IsAccountUsable. With allowMissing, an account that does not
+// exist yet, such as one about to be funded, is usable if the required assets are.
+func (b *Blockchain) accountUsable(address string, allowMissing bool) (usable bool, reason string, err error) {
+	if !addresscodec.IsValidClassicAddress(address) {
+		return false, "", fmt.Errorf("%w: %q", ErrInvalidAddress, address)
+	}
+
+	info, err := b.GetAccountInfo(address)
+	switch {
+	case err != nil && isRPCError(err, "actNotFound"):
+		if !allowMissing {
+			return false, "account does not exist", nil
+		}
+	case err != nil:
+		return false, "", err
+	case info.AccountData.Flags&lsfDisableMaster != 0 && info.AccountData.RegularKey == "":
+		return false, "account is blackholed: master key disabled and no regular key set", nil
+	}
+
+	issuer := b.SystemWallet().ClassicAddress.String()
+	issuerInfo, err := b.GetAccountInfo(issuer)
+	if err != nil {
+		return false, "", fmt.Errorf("failed to get RLUSD issuer: %w", err)
+	}
+	if issuerInfo.AccountData.Flags&lsfGlobalFreeze != 0 {
+		return false, fmt.Sprintf("RLUSD issuer %s froze its tokens globally", issuer), nil
+	}
+	return true, "", nil
+}
+
+// ProvisionAccounts funds the accounts and sets up their RLUSD trustlines with the system account
+// using a bounded pool of workers. An account found unusable, see IsAccountUsable, is not funded. Each account is funded with the live base reserve, the owner
+// reserve of its expected objects and its spendable Drops. A failing account does not stop the others, its error is
+// reported in its result. Submissions from the system wallet go through the sequence manager,
+// so the workers do not collide on the system account's Sequence.
+//
+// Parameters:
+// - specs: The accounts to provision
+// - concurrency: The maximum number of accounts provisioned at the same time
+//
+// Returns one result per spec in the same order, or an error if concurrency is not positive
+// or the reserves cannot be read.
+func (b *Blockchain) ProvisionAccounts(specs []AccountSpec, concurrency int) ([]ProvisionResult, error) {
+	if concurrency <= 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidConcurrency, concurrency)
+	}
+	base, inc, err := b.GetReserveParams()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ProvisionResult, len(specs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(specs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = b.provisionAccount(specs[i], base, inc)
+			}
+		}()
+	}
+
+	for i := range specs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results, nil
+}
+
+// provisionAccount funds a single account and waits for the payment to be validated,
+// so the account exists before it signs its trustline.
+func (b *Blockchain) provisionAccount(spec AccountSpec, base, inc uint64) ProvisionResult {
+	if spec.Wallet == nil {
+		return ProvisionResult{Err: fmt.Errorf("wallet cannot be nil")}
+	}
+	result := ProvisionResult{Address: spec.Wallet.ClassicAddress.String()}
+
+	usable, reason, err := b.accountUsable(result.Address, true)
+	if err != nil {
+		result.Err = fmt.Errorf("failed to check account: %w", err)
+		return result
+	}
+	if !usable {
+		result.Err = fmt.Errorf("%w: %s", ErrAccountUnusable, reason)
+		return result
+	}
+
+	owners := uint64(spec.ExpectedOwnerCount)
+	if spec.TrustlineLimit > 0 {
+		owners++
+	}
+	hash, err := b.SubmitTxAndWait(b.SystemWallet(), &transaction.Payment{
+		Amount:      types.XRPCurrencyAmount(base + owners*inc + spec.Drops),
+		Destination: spec.Wallet.ClassicAddress,
+	})
+	if err != nil {
+		result.Err = fmt.Errorf("failed to fund account: %w", err)
+		return result
+	}
+	result.FundHash = hash
+
+	if spec.TrustlineLimit > 0 {
+		if _, err := b.CreateTrustlineFromSystemAccount(spec.Wallet, spec.TrustlineLimit); err != nil {
+			result.Err = fmt.Errorf("failed to create trustline: %w", err)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+// serverStateFixture is a server_state result with a 1 XRP base reserve and a 0.2 XRP owner reserve.
+const serverStateFixture = `{
+	"state": {
+		"build_version": "2.4.0",
+		"complete_ledgers": "1-200",
+		"server_state": "full",
+		"validated_ledger": {
+			"base_fee": 10,
+			"close_time": 750000000,
+			"hash": "4BC50C9B0D8515D3EAAE1E74B29A95804346C491EE1A95BF25E4AAB854A6A652",
+			"reserve_base": 1000000,
+			"reserve_inc": 200000,
+			"seq": 200
+		}
+	}
+}`
+
+func TestBlockchain_GetReserveParams(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"server_state": fixtureResult(serverStateFixture),
+	})
+
+	base, inc, err := bc.GetReserveParams()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1_000_000), base)
+	assert.Equal(t, uint64(200_000), inc)
+}
+
+func TestBlockchain_GetReserveParams_NoValidatedLedger(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"server_state": func(params map[string]any) map[string]any {
+			return map[string]any{"state": map[string]any{"server_state": "connected"}}
+		},
+	})
+
+	_, _, err := bc.GetReserveParams()
+	assert.True(t, errors.Is(err, ErrReserveUnavailable), "got %v", err)
+}
+
+func TestBlockchain_ProvisionAccounts_FundsReserve(t *testing.T) {
+	w, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["server_state"] = fixtureResult(serverStateFixture)
+	bc, node := newTestBlockchain(t, handlers)
+
+	results, err := bc.ProvisionAccounts([]AccountSpec{
+		{Wallet: w, Drops: 50, ExpectedOwnerCount: 2, TrustlineLimit: 1000},
+	}, 1)
+	assert.NoError(t, err)
+	if assert.Len(t, results, 1) {
+		assert.NoError(t, results[0].Err)
+	}
+
+	// Base reserve, three objects with the trustline, and the spendable drops
+	payment := submittedTx(t, node, 0)
+	assert.Equal(t, string(transaction.PaymentTx), payment["TransactionType"])
+	assert.Equal(t, "1600050", payment["Amount"])
+}
+
+func TestBlockchain_ProvisionAccounts(t *testing.T) {
+	wallets := make([]*wallet.Wallet, 4)
+	for i := range wallets {
+		w, err := crypto.NewWalletFromHexSeed(testHexSeed, fmt.Sprintf("m/44'/144'/0'/0/%d", i+1))
+		assert.NoError(t, err)
+		wallets[i] = w
+	}
+	failing := wallets[2].ClassicAddress.String()
+
+	// The node tracks the system account sequence and rejects payments to the failing account
+	var mu sync.Mutex
+	sequence := uint32(10)
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["server_state"] = fixtureResult(serverStateFixture)
+	handlers["account_info"] = func(params map[string]any) map[string]any {
+		mu.Lock()
+		defer mu.Unlock()
+		result := accountInfoResult(params["account"].(string), 0, "1000000000")(params)
+		result["account_data"].(map[string]any)["Sequence"] = sequence
+		return result
+	}
+	submit := handlers["submit"]
+	handlers["submit"] = func(params map[string]any) map[string]any {
+		tx, err := binarycodec.Decode(params["tx_blob"].(string))
+		if err != nil {
+			return map[string]any{"error": "invalidTransaction", "status": "error"}
+		}
+		if tx["Destination"] == failing {
+			return map[string]any{
+				"engine_result":         "tecNO_DST_INSUF_XRP",
+				"engine_result_message": "Destination does not exist. Too little XRP sent to create it.",
+				"tx_json":               tx,
+			}
+		}
+		if tx["Account"] == testAddress {
+			mu.Lock()
+			sequence++
+			mu.Unlock()
+		}
+		return submit(params)
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	specs := []AccountSpec{
+		{Wallet: wallets[0], Drops: 10_000_000, TrustlineLimit: 1000},
+		{Wallet: wallets[1], Drops: 10_000_000},
+		{Wallet: wallets[2], Drops: 1},
+		{Wallet: nil, Drops: 10_000_000},
+		{Wallet: wallets[3], Drops: 10_000_000, TrustlineLimit: 1000},
+	}
+	results, err := bc.ProvisionAccounts(specs, 3)
+	assert.NoError(t, err)
+	if !assert.Len(t, results, len(specs)) {
+		return
+	}
+
+	for _, i := range []int{0, 1, 4} {
+		assert.NoError(t, results[i].Err, "spec %d", i)
+		assert.Equal(t, specs[i].Wallet.ClassicAddress.String(), results[i].Address)
+		assert.NotEmpty(t, results[i].FundHash)
+	}
+	assert.Equal(t, failing, results[2].Address)
+	var engineErr *EngineError
+	assert.True(t, errors.As(results[2].Err, &engineErr))
+	assert.Empty(t, results[2].FundHash)
+	assert.Error(t, results[3].Err)
+
+	// Every accepted system account transaction used its own sequence
+	seen := make(map[uint32]bool)
+	for i := range node.Params("submit") {
+		tx := submittedTx(t, node, i)
+		if tx["Account"] != testAddress || tx["Destination"] == failing {
+			continue
+		}
+		seq := tx["Sequence"].(uint32)
+		assert.False(t, seen[seq], "sequence %d used twice", seq)
+		seen[seq] = true
+	}
+	// 3 fundings and 2 trustlines from the system account
+	assert.Len(t, seen, 5)
+
+	// Trustlines are signed by the provisioned accounts too
+	trustSets := 0
+	for i := range node.Params("submit") {
+		if submittedTx(t, node, i)["TransactionType"] == string(transaction.TrustSetTx) {
+			trustSets++
+		}
+	}
+	assert.Equal(t, 4, trustSets)
+}
+
+func TestBlockchain_IsAccountUsable(t *testing.T) {
+	const blackholed = "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"
+	const missing = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["account_info"] = func(params map[string]any) map[string]any {
+		switch params["account"] {
+		case blackholed:
+			return accountInfoResult(blackholed, lsfDisableMaster, "1000000000")(params)
+		case missing:
+			return map[string]any{"error": "actNotFound", "status": "error"}
+		}
+		return accountInfoResult(params["account"].(string), 0, "1000000000")(params)
+	}
+	bc, _ := newTestBlockchain(t, handlers)
+
+	usable, reason, err := bc.IsAccountUsable(testAddress)
+	assert.NoError(t, err)
+	assert.True(t, usable)
+	assert.Empty(t, reason)
+
+	usable, reason, err = bc.IsAccountUsable(blackholed)
+	assert.NoError(t, err)
+	assert.False(t, usable)
+	assert.Contains(t, reason, "blackholed")
+
+	usable, reason, err = bc.IsAccountUsable(missing)
+	assert.NoError(t, err)
+	assert.False(t, usable)
+	assert.Contains(t, reason, "does not exist")
+
+	_, _, err = bc.IsAccountUsable("rInvalid")
+	assert.True(t, errors.Is(err, ErrInvalidAddress), "got %v", err)
+}
+
+func TestBlockchain_IsAccountUsable_RegularKey(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["account_info"] = func(params map[string]any) map[string]any {
+		result := accountInfoResult(params["account"].(string), lsfDisableMaster, "1000000000")(params)
+		result["account_data"].(map[string]any)["RegularKey"] = "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"
+		return result
+	}
+	bc, _ := newTestBlockchain(t, handlers)
+
+	usable, _, err := bc.IsAccountUsable(testAddress)
+	assert.NoError(t, err)
+	assert.True(t, usable)
+}
+
+func TestBlockchain_ProvisionAccounts_SkipsBlackholed(t *testing.T) {
+	w, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["server_state"] = fixtureResult(serverStateFixture)
+	handlers["account_info"] = func(params map[string]any) map[string]any {
+		var flags uint32
+		if params["account"] == w.ClassicAddress.String() {
+			flags = lsfDisableMaster
+		}
+		return accountInfoResult(params["account"].(string), flags, "1000000000")(params)
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	results, err := bc.ProvisionAccounts([]AccountSpec{{Wallet: w, Drops: 50}}, 1)
+	assert.NoError(t, err)
+	if assert.Len(t, results, 1) {
+		assert.True(t, errors.Is(results[0].Err, ErrAccountUnusable), "got %v", results[0].Err)
+		assert.Empty(t, results[0].FundHash)
+	}
+	assert.Zero(t, node.Calls("submit"))
+}
+
+func TestBlockchain_ProvisionAccounts_InvalidConcurrency(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	_, err := bc.ProvisionAccounts([]AccountSpec{{Drops: 1}}, 0)
+	assert.True(t, errors.Is(err, ErrInvalidConcurrency))
+	assert.Equal(t, 0, node.TotalCalls())
+}
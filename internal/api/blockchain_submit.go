@@ -0,0 +1,282 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+var (
+	// ErrBlobNotSigned is returned when a transaction blob carries neither a signature, a signing public key nor signers.
+	ErrBlobNotSigned = errors.New("transaction blob is not signed")
+)
+
+// prepareFlatTransaction flattens a transaction to be signed by the wallet: its Account is
+// the wallet's address and its SigningPubKey the wallet's public key, whatever the
+// transaction set them to.
+//
+// Parameters:
+// - w: The wallet that signs the transaction
+// - tx: The transaction to flatten
+//
+// Returns the flattened transaction.
+func prepareFlatTransaction(w *wallet.Wallet, tx SubmittableTransaction) transactions.FlatTransaction {
+	flattenedTx := flattenTransaction(tx)
+	flattenedTx["Account"] = w.ClassicAddress.String()
+	flattenedTx["SigningPubKey"] = w.PublicKey
+	return flattenedTx
+}
+
+// flattenTransaction flattens a transaction with its address fields as strings. Some
+// transactions, such as the escrow ones, flatten addresses as types.Address, which neither
+// the client's autofill nor the binary codec accept.
+func flattenTransaction(tx SubmittableTransaction) transactions.FlatTransaction {
+	flattenedTx := tx.Flatten()
+	for field, value := range flattenedTx {
+		if address, ok := value.(types.Address); ok {
+			flattenedTx[field] = address.String()
+		}
+	}
+	return flattenedTx
+}
+
+// SubmitBlob submits a transaction that was signed outside of the service.
+// The blob is decoded first so that an unsigned transaction, or one of a type that is not
+// allowed, is rejected before it reaches the node. It is logged, audited and measured like
+// the transactions the service signs.
+//
+// Parameters:
+// - blob: The hex encoded signed transaction
+// - failHard: Whether the node should not retry or relay the transaction if it fails locally
+//
+// Returns the transaction hash if successful, an *EngineError if the node does not apply it,
+// or an error if the blob is invalid or the request fails.
+func (b *Blockchain) SubmitBlob(blob string, failHard bool) (txHash string, err error) {
+	return b.submitBlob(blob, failHard, nil)
+}
+
+// submitBlob submits a signed blob. A blob signed with a sequence reserved by SignTx is
+// given the release of the reservation, which is ended once the submit result is known;
+// otherwise the account's next sequence is read again from the node afterwards.
+func (b *Blockchain) submitBlob(blob string, failHard bool, release func(consumed bool)) (txHash string, err error) {
+	consumed := false
+	if release != nil {
+		defer func() { release(consumed) }()
+	}
+
+	tx, err := binarycodec.Decode(blob)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode transaction blob: %w", err)
+	}
+	txType, _ := tx["TransactionType"].(string)
+	if err := b.checkTxTypeAllowed(transactions.TxType(txType)); err != nil {
+		return "", err
+	}
+	defer func(start time.Time) {
+		b.observeSubmit(transactions.TxType(txType), start, feeDrops(tx), err)
+	}(time.Now())
+
+	txnSignature, _ := tx["TxnSignature"].(string)
+	signingPubKey, _ := tx["SigningPubKey"].(string)
+	_, multisigned := tx["Signers"]
+	if txnSignature == "" && signingPubKey == "" && !multisigned {
+		return "", ErrBlobNotSigned
+	}
+
+	account, ok := tx["Account"].(string)
+	if ok {
+		defer b.accounts.invalidate(account)
+		if release == nil {
+			// The blob carries its own sequence, read the next one again from the node
+			defer b.sequences.forget(account)
+		}
+		defer b.txnIDs.forget(account)
+	}
+
+	txHash, err = hash.SignTxBlob(blob)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute transaction hash: %w", err)
+	}
+	if err := b.logSignedTransaction(txHash, tx); err != nil {
+		return "", err
+	}
+
+	res, err := b.c.Request(&requests.SubmitRequest{
+		TxBlob:   blob,
+		FailHard: failHard,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to submit blob: %w", err)
+	}
+
+	var resp requests.SubmitResponse
+	if err := res.GetResult(&resp); err != nil {
+		return "", fmt.Errorf("failed to parse submit response: %w", err)
+	}
+
+	consumed = sequenceConsumed(resp.EngineResult)
+	if resp.EngineResult != string(transactions.TesSUCCESS) {
+		return "", &EngineError{
+			Result:        transactions.TxResult(resp.EngineResult),
+			ResultMessage: resp.EngineResultMessage,
+		}
+	}
+
+	tx["hash"] = txHash
+	b.audit(transactions.TxType(txType), account, tx)
+	return txHash, nil
+}
+
+// SignTx autofills and signs a transaction without submitting it.
+// The hash is known before the node sees the transaction, so it can be logged first and
+// used to look the transaction up if the submission times out. The sequence of a system
+// wallet transaction stays reserved until the blob is sent with SubmitSignedBlob or
+// dropped with DiscardSignedTx: other submissions of the account, including further
+// signed transactions, take the following sequences without waiting for it.
+//
+// Parameters:
+// - w: The wallet used to sign the transaction
+// - tx: The transaction to sign
+//
+// Returns the signed blob, to be sent with SubmitSignedBlob, and its hash, or an error if
+// autofill or signing fails.
+func (b *Blockchain) SignTx(w *wallet.Wallet, tx SubmittableTransaction) (blob, txHash string, err error) {
+	if w == nil {
+		return "", "", fmt.Errorf("wallet cannot be nil")
+	}
+	if tx == nil {
+		return "", "", fmt.Errorf("transaction cannot be nil")
+	}
+	if err := b.checkTxTypeAllowed(tx.TxType()); err != nil {
+		return "", "", err
+	}
+
+	flattenedTx := prepareFlatTransaction(w, tx)
+	if err := resolveXAddresses(flattenedTx); err != nil {
+		return "", "", fmt.Errorf("invalid transaction: %w", err)
+	}
+	if err := b.setLastLedgerSequence(flattenedTx); err != nil {
+		return "", "", err
+	}
+	release, err := b.reserveSignedSequence(flattenedTx)
+	if err != nil {
+		return "", "", err
+	}
+	held := false
+	defer func() {
+		if !held {
+			release(false)
+		}
+	}()
+
+	if err := b.c.Autofill(&flattenedTx); err != nil {
+		return "", "", fmt.Errorf("failed to autofill tx: %w", err)
+	}
+	blob, _, err = w.Sign(flattenedTx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to sign tx: %w", err)
+	}
+	if err := checkCanonicalBlob(blob); err != nil {
+		return "", "", err
+	}
+
+	txHash, err = hash.SignTxBlob(blob)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to compute transaction hash: %w", err)
+	}
+	b.held.hold(txHash, release)
+	held = true
+	return blob, txHash, nil
+}
+
+// ComputeTxHash returns the canonical hash the transaction will have once autofilled and signed,
+// without submitting it. Autofill depends on the ledger state, so use SignTx when the
+// same transaction is to be submitted afterwards.
+//
+// Parameters:
+// - w: The wallet used to sign the transaction
+// - tx: The transaction to hash
+//
+// Returns the transaction hash, or an error if autofill or signing fails.
+func (b *Blockchain) ComputeTxHash(w *wallet.Wallet, tx SubmittableTransaction) (string, error) {
+	_, txHash, err := b.SignTx(w, tx)
+	if err != nil {
+		return "", err
+	}
+	b.DiscardSignedTx(txHash)
+	return txHash, nil
+}
+
+// SubmitSignedBlob submits a blob signed with SignTx. The sequence reserved when signing is
+// released once the submit result is known.
+//
+// Parameters:
+// - blob: The hex encoded signed transaction
+//
+// Returns the transaction hash if successful, an *EngineError if the node does not apply it,
+// or an error if the request fails.
+func (b *Blockchain) SubmitSignedBlob(blob string) (txHash string, err error) {
+	var release func(consumed bool)
+	if txHash, err := hash.SignTxBlob(blob); err == nil {
+		release, _ = b.held.take(txHash)
+	}
+	return b.submitBlob(blob, false, release)
+}
+
+// DiscardSignedTx drops a transaction signed with SignTx that will not be submitted,
+// releasing the sequence reserved for it. Discarding an unknown hash does nothing.
+//
+// Parameters:
+// - txHash: The hash returned by SignTx
+func (b *Blockchain) DiscardSignedTx(txHash string) {
+	if release, ok := b.held.take(txHash); ok {
+		release(false)
+	}
+}
+
+// checkCanonicalBlob checks the signature, or every signature of a multi-signed transaction,
+// of a signed blob is fully canonical, so that a regression of the signing library is caught
+// before the node rejects the transaction.
+func checkCanonicalBlob(blob string) error {
+	tx, err := binarycodec.Decode(blob)
+	if err != nil {
+		return fmt.Errorf("failed to decode signed tx: %w", err)
+	}
+	return checkCanonicalSignatures(tx)
+}
+
+// checkCanonicalSignatures checks the signature, or every signature of a multi-signed
+// transaction, of a decoded signed transaction is fully canonical.
+func checkCanonicalSignatures(tx map[string]any) error {
+	if signature, _ := tx["TxnSignature"].(string); signature != "" {
+		pubKey, _ := tx["SigningPubKey"].(string)
+		if err := crypto.CheckCanonicalSignature(signature, pubKey); err != nil {
+			return err
+		}
+	}
+	signers, _ := tx["Signers"].([]any)
+	for i, s := range signers {
+		entry, ok := s.(map[string]any)
+		if !ok {
+			return fmt.Errorf("malformed signers entry %d: %v", i, s)
+		}
+		signer, ok := entry["Signer"].(map[string]any)
+		if !ok {
+			return fmt.Errorf("malformed signers entry %d: no signer", i)
+		}
+		signature, _ := signer["TxnSignature"].(string)
+		pubKey, _ := signer["SigningPubKey"].(string)
+		if err := crypto.CheckCanonicalSignature(signature, pubKey); err != nil {
+			return fmt.Errorf("signer %v: %w", signer["Account"], err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package api
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	xrplcrypto "github.com/Peersyst/xrpl-go/pkg/crypto"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+// testPaymentFlatTx returns a fully filled payment ready to be encoded or signed.
+func testPaymentFlatTx() map[string]any {
+	return map[string]any{
+		"TransactionType":    "Payment",
+		"Account":            testAddress,
+		"Destination":        "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount":             "1000",
+		"Fee":                "12",
+		"Sequence":           uint32(10),
+		"LastLedgerSequence": uint32(120),
+		"Flags":              uint32(0),
+	}
+}
+
+func TestBlockchain_SubmitBlob(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	blob, expectedHash, err := bc.w.Sign(testPaymentFlatTx())
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+
+	hash, err := bc.SubmitBlob(blob, false)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedHash, hash)
+	assert.Equal(t, 1, node.Calls("submit"))
+}
+
+func TestBlockchain_SubmitBlob_Unsigned(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	blob, err := binarycodec.Encode(testPaymentFlatTx())
+	if err != nil {
+		t.Fatalf("failed to encode transaction: %v", err)
+	}
+
+	hash, err := bc.SubmitBlob(blob, false)
+	assert.Empty(t, hash)
+	assert.True(t, errors.Is(err, ErrBlobNotSigned))
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_SubmitBlob_EngineResult(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tecUNFUNDED_PAYMENT"))
+
+	blob, _, err := bc.w.Sign(testPaymentFlatTx())
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+
+	_, err = bc.SubmitBlob(blob, false)
+	var engineErr *EngineError
+	if assert.True(t, errors.As(err, &engineErr)) {
+		assert.Equal(t, transactions.TecUNFUNDED_PAYMENT, engineErr.Result)
+	}
+}
+
+func TestBlockchain_SignTx_HashMatchesNode(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	blob, computedHash, err := bc.SignTx(bc.w, &transactions.Payment{
+		Amount:      types.XRPCurrencyAmount(1000),
+		Destination: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, computedHash)
+	assert.Equal(t, 0, node.Calls("submit"))
+
+	hash, err := bc.SubmitSignedBlob(blob)
+	assert.NoError(t, err)
+	assert.Equal(t, computedHash, hash)
+	assert.Equal(t, blob, node.Params("submit")[0]["tx_blob"])
+
+	// The node knows the transaction by the computed hash
+	resp, _, _, err := bc.GetTransactionInfo(computedHash)
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, computedHash, string(resp.Hash))
+	}
+}
+
+func TestBlockchain_SignTx_ReservesSequence(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	blob, _, err := bc.SignTx(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+
+	// Another submission of the system wallet does not wait for the signed blob,
+	// it takes the next sequence
+	submitted := make(chan error, 1)
+	go func() {
+		_, err := bc.SubmitTx(bc.w, &transactions.AccountSet{})
+		submitted <- err
+	}()
+	select {
+	case err := <-submitted:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("submission waits for the signed blob")
+	}
+
+	_, err = bc.SubmitSignedBlob(blob)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 11, submittedTx(t, node, 0)["Sequence"])
+	assert.EqualValues(t, 10, submittedTx(t, node, 1)["Sequence"])
+}
+
+func TestBlockchain_SignTx_TwiceBeforeSubmitting(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// Sign two transactions, log their hashes, then submit both
+	signed := make(chan [2]string, 1)
+	go func() {
+		first, _, err := bc.SignTx(bc.w, &transactions.AccountSet{})
+		assert.NoError(t, err)
+		second, _, err := bc.SignTx(bc.w, &transactions.AccountSet{})
+		assert.NoError(t, err)
+		signed <- [2]string{first, second}
+	}()
+	var blobs [2]string
+	select {
+	case blobs = <-signed:
+	case <-time.After(time.Second):
+		t.Fatal("second SignTx waits for the first blob to be submitted")
+	}
+
+	for _, blob := range blobs {
+		_, err := bc.SubmitSignedBlob(blob)
+		assert.NoError(t, err)
+	}
+	assert.EqualValues(t, 10, submittedTx(t, node, 0)["Sequence"])
+	assert.EqualValues(t, 11, submittedTx(t, node, 1)["Sequence"])
+
+	// The next submission follows the submitted blobs
+	_, err := bc.SubmitTx(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 12, submittedTx(t, node, 2)["Sequence"])
+}
+
+func TestBlockchain_DiscardSignedTx(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, txHash, err := bc.SignTx(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+	bc.DiscardSignedTx(txHash)
+	bc.DiscardSignedTx(txHash)
+
+	// The discarded sequence is used by the next submission
+	_, err = bc.SubmitTx(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, submittedTx(t, node, 0)["Sequence"])
+
+	// A discarded sequence followed by a held one is read again from the node
+	_, first, err := bc.SignTx(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+	_, _, err = bc.SignTx(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+	fetches := node.Calls("account_info")
+	bc.DiscardSignedTx(first)
+	_, err = bc.SubmitTx(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+	assert.Greater(t, node.Calls("account_info"), fetches)
+}
+
+func TestBlockchain_ComputeTxHash(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	txHash, err := bc.ComputeTxHash(bc.w, &transactions.AccountSet{})
+	assert.NoError(t, err)
+	assert.Len(t, txHash, 64)
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestBlockchain_SubmitTxDetailed(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	resp, err := bc.SubmitTxDetailed(bc.w, &transactions.AccountSet{})
+	if assert.NoError(t, err) {
+		assert.Equal(t, string(transactions.TesSUCCESS), resp.EngineResult)
+		assert.Equal(t, "The transaction was applied.", resp.EngineResultMessage)
+		assert.NotEmpty(t, resp.Tx["hash"])
+	}
+}
+
+func TestBlockchain_SubmitTxDetailed_EngineFailure(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tecNO_DST"))
+
+	resp, err := bc.SubmitTxDetailed(bc.w, &transactions.AccountSet{})
+	assert.True(t, errors.Is(err, &EngineError{Result: "tecNO_DST"}), "got %v", err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, "tecNO_DST", resp.EngineResult)
+		assert.Equal(t, "The transaction was applied.", resp.EngineResultMessage)
+	}
+}
+
+func TestCheckCanonicalBlob(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	// The system wallet signs with Ed25519, whose signatures have a single encoding
+	w, err := crypto.NewWalletFromSeed("snoPBrXtMeMyMHUVTgbuqAfg1SUTb", crypto.KeyAlgorithmSecp256k1)
+	assert.NoError(t, err)
+
+	blob, _, err := bc.SignTx(w, &transactions.Payment{
+		Amount:      types.XRPCurrencyAmount(1000),
+		Destination: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, checkCanonicalBlob(blob))
+
+	// Swapping in the high-S twin of the signature keeps it valid but not canonical
+	tx, err := binarycodec.Decode(blob)
+	assert.NoError(t, err)
+	r, s, err := xrplcrypto.DERHexToSig(tx["TxnSignature"].(string))
+	assert.NoError(t, err)
+	order, _ := new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", 16)
+	highS := new(big.Int).Sub(order, new(big.Int).SetBytes(s))
+	tx["TxnSignature"], err = xrplcrypto.DERHexFromSig(new(big.Int).SetBytes(r).Text(16), highS.Text(16))
+	assert.NoError(t, err)
+	tampered, err := binarycodec.Encode(tx)
+	assert.NoError(t, err)
+
+	err = checkCanonicalBlob(tampered)
+	assert.True(t, errors.Is(err, crypto.ErrNonCanonicalSignature), "got %v", err)
+}
+
+func TestCheckCanonicalSignatures_MalformedSigners(t *testing.T) {
+	for _, signers := range [][]any{
+		{"not-an-entry"},
+		{map[string]any{"Account": testAddress}},
+		{map[string]any{"Signer": "not-a-signer"}},
+	} {
+		err := checkCanonicalSignatures(map[string]any{"Signers": signers})
+		assert.ErrorContains(t, err, "malformed signers entry 0")
+	}
+}
+
+func TestPrepareFlatTransaction(t *testing.T) {
+	w, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+
+	// The wallet overrides whatever account the transaction names
+	tx := &transactions.AccountSet{BaseTx: transactions.BaseTx{Account: types.Address(testAddress)}}
+	flat := prepareFlatTransaction(w, tx)
+
+	assert.Equal(t, w.ClassicAddress.String(), flat["Account"])
+	assert.Equal(t, w.PublicKey, flat["SigningPubKey"])
+	assert.Equal(t, string(transactions.AccountSetTx), flat["TransactionType"])
+}
@@ -0,0 +1,472 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	binarycodec "github.com/Peersyst/xrpl-go/binary-codec"
+	"github.com/Peersyst/xrpl-go/xrpl/hash"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+// rpcHandler returns the "result" object for a single JSON-RPC call.
+type rpcHandler func(params map[string]any) map[string]any
+
+// stubNode is a minimal rippled JSON-RPC stub answering calls by method name.
+type stubNode struct {
+	mu       sync.Mutex
+	handlers map[string]rpcHandler
+	calls    map[string]int
+	params   map[string][]map[string]any
+	headers  []http.Header
+}
+
+func newStubNode(t *testing.T, handlers map[string]rpcHandler) (*stubNode, *httptest.Server) {
+	t.Helper()
+	n := &stubNode{
+		handlers: handlers,
+		calls:    make(map[string]int),
+		params:   make(map[string][]map[string]any),
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string           `json:"method"`
+			Params []map[string]any `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		params := map[string]any{}
+		if len(req.Params) > 0 {
+			params = req.Params[0]
+		}
+
+		n.mu.Lock()
+		n.calls[req.Method]++
+		n.params[req.Method] = append(n.params[req.Method], params)
+		n.headers = append(n.headers, r.Header.Clone())
+		h, ok := n.handlers[req.Method]
+		n.mu.Unlock()
+
+		result := map[string]any{"error": "unknownCmd", "status": "error"}
+		if ok {
+			result = h(params)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"result": result})
+	}))
+	t.Cleanup(srv.Close)
+
+	return n, srv
+}
+
+// Calls returns how many times the method was requested.
+func (n *stubNode) Calls(method string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.calls[method]
+}
+
+// TotalCalls returns how many requests the node received.
+func (n *stubNode) TotalCalls() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	total := 0
+	for _, c := range n.calls {
+		total += c
+	}
+	return total
+}
+
+// Params returns the parameters of every call of the method.
+func (n *stubNode) Params(method string) []map[string]any {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.params[method]
+}
+
+// newTestBlockchain creates a Blockchain talking to a stub node with a system wallet
+// derived from testHexSeed.
+func newTestBlockchain(t *testing.T, handlers map[string]rpcHandler) (*Blockchain, *stubNode) {
+	t.Helper()
+	node, srv := newStubNode(t, handlers)
+
+	reqCtx := &requestContext{}
+	cfg, err := rpc.NewClientConfig(srv.URL, rpc.WithHTTPClient(&contextHTTPClient{
+		HTTPClient: &http.Client{Timeout: 5 * time.Second},
+		reqCtx:     reqCtx,
+	}))
+	if err != nil {
+		t.Fatalf("failed to create rpc config: %v", err)
+	}
+
+	w, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/0")
+	if err != nil {
+		t.Fatalf("failed to create wallet: %v", err)
+	}
+
+	pollCfg := *cfg
+	pollCfg.HTTPClient = &http.Client{Timeout: 5 * time.Second}
+
+	return &Blockchain{c: rpc.NewClient(cfg), poll: rpc.NewClient(&pollCfg), w: w, reqCtx: reqCtx}, node
+}
+
+// accountInfoResult builds an account_info result for the given account and flags.
+func accountInfoResult(address string, flags uint32, balance string) rpcHandler {
+	return func(params map[string]any) map[string]any {
+		return map[string]any{
+			"account_data": map[string]any{
+				"Account":         address,
+				"Balance":         balance,
+				"Flags":           flags,
+				"LedgerEntryType": "AccountRoot",
+				"OwnerCount":      0,
+				"Sequence":        10,
+			},
+			"ledger_index": 100,
+			"validated":    true,
+		}
+	}
+}
+
+// fixtureResult returns a handler answering with a captured JSON result.
+func fixtureResult(fixture string) rpcHandler {
+	return func(params map[string]any) map[string]any {
+		var result map[string]any
+		if err := json.Unmarshal([]byte(fixture), &result); err != nil {
+			panic(err)
+		}
+		return result
+	}
+}
+
+// submitHandlers returns the handlers needed to autofill, sign, submit and wait for a transaction.
+// The submit handler answers with the given engine result and the decoded transaction,
+// the tx handler reports a submitted transaction as validated past its LastLedgerSequence.
+func submitHandlers(engineResult string) map[string]rpcHandler {
+	var mu sync.Mutex
+	submitted := make(map[string]map[string]any)
+
+	return map[string]rpcHandler{
+		"account_info": accountInfoResult(testAddress, 0, "1000000000"),
+		"server_info": func(params map[string]any) map[string]any {
+			return map[string]any{
+				"info": map[string]any{
+					"build_version": "2.4.0",
+					"load_factor":   1,
+					"validated_ledger": map[string]any{
+						"base_fee_xrp":     0.00001,
+						"reserve_base_xrp": 1,
+						"reserve_inc_xrp":  0.2,
+						"seq":              100,
+					},
+				},
+			}
+		},
+		"ledger": func(params map[string]any) map[string]any {
+			return map[string]any{"ledger_index": 100, "validated": true}
+		},
+		"account_lines": func(params map[string]any) map[string]any {
+			return map[string]any{"account": params["account"], "lines": []any{}}
+		},
+		"submit": func(params map[string]any) map[string]any {
+			blob, _ := params["tx_blob"].(string)
+			tx, err := binarycodec.Decode(blob)
+			if err != nil {
+				return map[string]any{"error": "invalidTransaction", "status": "error"}
+			}
+			txHash, err := hash.SignTxBlob(blob)
+			if err != nil {
+				return map[string]any{"error": "invalidTransaction", "status": "error"}
+			}
+			tx["hash"] = txHash
+			mu.Lock()
+			submitted[txHash] = tx
+			mu.Unlock()
+			return map[string]any{
+				"engine_result":         engineResult,
+				"engine_result_code":    0,
+				"engine_result_message": "The transaction was applied.",
+				"tx_blob":               blob,
+				"tx_json":               tx,
+				"accepted":              true,
+				"applied":               true,
+			}
+		},
+		"tx": func(params map[string]any) map[string]any {
+			txHash, _ := params["transaction"].(string)
+			mu.Lock()
+			tx, ok := submitted[txHash]
+			mu.Unlock()
+			if !ok {
+				return map[string]any{"error": "txnNotFound", "status": "error"}
+			}
+			return map[string]any{
+				"hash":         txHash,
+				"ledger_index": 200,
+				"validated":    true,
+				"meta":         map[string]any{"TransactionResult": engineResult},
+				"tx_json":      tx,
+			}
+		},
+	}
+}
+
+// submittedTx decodes the n-th blob sent to the submit method.
+func submittedTx(t *testing.T, node *stubNode, n int) map[string]any {
+	t.Helper()
+	params := node.Params("submit")
+	if len(params) <= n {
+		t.Fatalf("expected at least %d submissions, got %d", n+1, len(params))
+	}
+	tx, err := binarycodec.Decode(params[n]["tx_blob"].(string))
+	if err != nil {
+		t.Fatalf("failed to decode submitted blob: %v", err)
+	}
+	return tx
+}
+
+func TestBlockchain_Clawback(t *testing.T) {
+	holder := "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["account_info"] = accountInfoResult(testAddress, lsfAllowTrustLineClawback, "1000000000")
+	handlers["account_lines"] = func(params map[string]any) map[string]any {
+		return map[string]any{
+			"account": holder,
+			"lines": []map[string]any{
+				{"account": testAddress, "currency": RLUSDHex, "balance": "100", "limit": "1000"},
+			},
+		}
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	hash, err := bc.Clawback(bc.w, holder, decimal.NewFromInt(10), RLUSDHex)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "Clawback", tx["TransactionType"])
+	amount := tx["Amount"].(map[string]any)
+	assert.Equal(t, holder, amount["issuer"])
+	assert.Equal(t, "10", amount["value"])
+}
+
+func TestBlockchain_Clawback_NotEnabled(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"account_info": accountInfoResult(testAddress, 0, "1000000000"),
+	})
+
+	hash, err := bc.Clawback(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", decimal.NewFromInt(10), RLUSDHex)
+	assert.Empty(t, hash)
+	assert.True(t, errors.Is(err, ErrClawbackNotEnabled))
+	assert.Equal(t, 1, node.Calls("account_info"))
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestBlockchain_Clawback_InvalidAmount(t *testing.T) {
+	bc, node := newTestBlockchain(t, nil)
+
+	_, err := bc.Clawback(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", decimal.Zero, RLUSDHex)
+	assert.Error(t, err)
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+// setTestSystemAccount sets a consistent system keypair, NewBlockchain rejects mismatched keys.
+func setTestSystemAccount(cfg *config.NetworkConfig) {
+	cfg.System.Account = "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"
+	cfg.System.Public = "EDF8E0C585E753AF306F88718CAD36968498FDC11C3B762FB8ACEAA6B3AEF59ECD"
+	cfg.System.Secret = "ED314A5DDC93264D2CB91E1ED92B69F9AEDB7FA14027649D3F300B462B0355DEDD"
+}
+
+func TestNewBlockchain_Headers(t *testing.T) {
+	node, srv := newStubNode(t, map[string]rpcHandler{
+		"account_info": accountInfoResult(testAddress, 0, "1000000000"),
+	})
+
+	cfg := config.NetworkConfig{
+		URL:       srv.URL,
+		Timeout:   5,
+		Headers:   map[string]string{"X-Api-Key": "secret-key"},
+		AuthToken: "token",
+	}
+	setTestSystemAccount(&cfg)
+
+	bc, err := NewBlockchain(cfg)
+	assert.NoError(t, err)
+
+	_, err = bc.GetAccountInfo(testAddress)
+	assert.NoError(t, err)
+
+	if assert.Len(t, node.headers, 1) {
+		assert.Equal(t, "secret-key", node.headers[0].Get("X-Api-Key"))
+		assert.Equal(t, "Bearer token", node.headers[0].Get("Authorization"))
+		assert.Equal(t, "application/json", node.headers[0].Get("Content-Type"))
+	}
+}
+
+func TestNewBlockchain_NetworkID(t *testing.T) {
+	tests := []struct {
+		name      string
+		networkID uint32
+		want      uint32
+	}{
+		{name: "sidechain", networkID: 21338, want: 21338},
+		{name: "restricted network", networkID: 1, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, srv := newStubNode(t, map[string]rpcHandler{
+				"server_info": func(params map[string]any) map[string]any {
+					return map[string]any{
+						"info": map[string]any{
+							"build_version": "2.4.0",
+							"network_id":    tt.networkID,
+						},
+					}
+				},
+			})
+
+			cfg := config.NetworkConfig{URL: srv.URL, Timeout: 5, DetectNetworkID: true}
+			setTestSystemAccount(&cfg)
+
+			bc, err := NewBlockchain(cfg)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, bc.c.NetworkID)
+		})
+	}
+}
+
+func TestBlockchain_AuthorizeMPToken_AlreadyAuthorized(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tecDUPLICATE"))
+
+	err := bc.AuthorizeMPToken(bc.w, "00000007A407AF5856CCF3C42619DAA925813FC955C72983")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, node.Calls("submit"))
+}
+
+func TestBlockchain_AuthorizeMPToken_Failure(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tecOBJECT_NOT_FOUND"))
+
+	err := bc.AuthorizeMPToken(bc.w, "00000007A407AF5856CCF3C42619DAA925813FC955C72983")
+	assert.True(t, errors.Is(err, ErrMPTokenAuthorization), "got %v", err)
+	assert.True(t, errors.Is(err, &EngineError{Result: "tecOBJECT_NOT_FOUND"}), "got %v", err)
+}
+
+func TestBlockchain_SystemWallet_ConcurrentSubmissions(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	system := bc.SystemWallet()
+
+	const submissions = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, submissions)
+	for i := 0; i < submissions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := bc.PaymentXRPFromSystemAccount("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 1000)
+			errs <- err
+		}()
+	}
+	// Replacing the wallet races with the reads of the submissions
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		bc.SetSystemWallet(system)
+	}()
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+	sequences := make(map[any]struct{})
+	for i := 0; i < submissions; i++ {
+		sequences[submittedTx(t, node, i)["Sequence"]] = struct{}{}
+	}
+	assert.Len(t, sequences, submissions)
+}
+
+func TestBlockchain_SubmitTxAndWait_CommitsSequenceOnAccept(t *testing.T) {
+	var bc *Blockchain
+	var once sync.Once
+	next := make(chan uint32, 1)
+	handlers := submitHandlers("tesSUCCESS")
+	lookup := handlers["tx"]
+	handlers["tx"] = func(params map[string]any) map[string]any {
+		// Another submission of the system wallet gets a sequence while this one awaits validation
+		once.Do(func() {
+			acquired := make(chan uint32, 1)
+			go func() {
+				sequence, release, err := bc.sequences.acquire(bc.SystemWallet().ClassicAddress.String(),
+					func() (uint32, error) { return 0, errors.New("sequence not known") })
+				if err == nil {
+					release(false)
+				}
+				acquired <- sequence
+			}()
+			select {
+			case sequence := <-acquired:
+				next <- sequence
+			case <-time.After(time.Second):
+				next <- 0
+			}
+		})
+		return lookup(params)
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	_, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 10, submittedTx(t, node, 0)["Sequence"])
+	assert.EqualValues(t, 11, <-next)
+}
+
+func TestBlockchain_TryLock(t *testing.T) {
+	bc, _ := newTestBlockchain(t, nil)
+
+	assert.NoError(t, bc.TryLock(context.Background()))
+
+	// A second locker gives up at its deadline while the first holds the lock
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := bc.TryLock(ctx)
+	assert.True(t, errors.Is(err, ErrLockTimeout), "got %v", err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	// A waiting locker acquires the lock once it is released
+	acquired := make(chan error, 1)
+	go func() { acquired <- bc.TryLock(context.Background()) }()
+	bc.Unlock()
+	select {
+	case err := <-acquired:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("lock was not handed over")
+	}
+	bc.Unlock()
+}
+
+func TestBlockchain_Unlock_NotHeld(t *testing.T) {
+	bc, _ := newTestBlockchain(t, nil)
+
+	// Releasing a lock that is not held panics instead of blocking forever
+	assert.PanicsWithValue(t, "api: unlock of unlocked Blockchain", bc.Unlock)
+
+	bc.Lock()
+	bc.Unlock()
+	assert.Panics(t, bc.Unlock)
+}
@@ -0,0 +1,372 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	accounttypes "github.com/Peersyst/xrpl-go/xrpl/queries/account/types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// lsfAllowTrustLineClawback is the AccountRoot flag enabling clawback of issued currencies.
+	lsfAllowTrustLineClawback uint32 = 0x80000000
+)
+
+var (
+	// ErrClawbackNotEnabled is returned when the issuer account has not enabled trustline clawback.
+	ErrClawbackNotEnabled = errors.New("clawback is not enabled on issuer account")
+	// ErrTrustlineNotFound is returned when the expected trustline does not exist.
+	ErrTrustlineNotFound = errors.New("trustline not found")
+)
+
+// TrustlineRef identifies a trust line of the submitting account by its currency and
+// the account at the other end of the line, the issuer of its LimitAmount.
+type TrustlineRef struct {
+	Currency string
+	Issuer   string
+}
+
+// TrustlineResult is the outcome of a change to one trust line.
+type TrustlineResult struct {
+	Line TrustlineRef
+	// TxHash is the hash of the Batch transaction that changed the line, empty if it was not sent
+	TxHash string
+	// Err is why the line was not changed, nil if it was
+	Err error
+}
+
+// TrustlineView is a trust line as seen from the account holding it.
+type TrustlineView struct {
+	Currency string
+	// Issuer is the account at the other end of the line
+	Issuer string
+	// Balance is signed from the account's perspective, negative when it owes the currency
+	Balance decimal.Decimal
+	// Limit is the account's limit on the line
+	Limit decimal.Decimal
+}
+
+// HasTrustline reports whether the holder has a trustline for the currency issued by the issuer.
+//
+// Parameters:
+// - holder: The account address holding the trustline
+// - currency: The currency code (3-char or 40-char hex)
+// - issuer: The issuer account address
+//
+// Returns true if the trustline exists, or an error if the request fails.
+func (b *Blockchain) HasTrustline(holder, currency, issuer string) (bool, error) {
+	_, err := b.GetTrustline(holder, currency, issuer)
+	if errors.Is(err, ErrTrustlineNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetTrustline retrieves the holder's trustline for the currency issued by the issuer,
+// as seen from the holder: Limit is the holder's limit and LimitPeer the issuer's.
+//
+// Parameters:
+// - holder: The account address holding the trustline
+// - currency: The currency code (3-char or 40-char hex)
+// - issuer: The issuer account address
+//
+// Returns the trustline, or an error wrapping ErrTrustlineNotFound if it does not exist.
+func (b *Blockchain) GetTrustline(holder, currency, issuer string) (*accounttypes.TrustLine, error) {
+	lines, err := b.c.GetAccountLines(&account.LinesRequest{
+		Account:     types.Address(holder),
+		Peer:        types.Address(issuer),
+		LedgerIndex: common.Validated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account lines: %w", err)
+	}
+
+	for _, line := range lines.Lines {
+		if line.Currency == currency && string(line.Account) == issuer {
+			return &line, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: holder %s for currency %s", ErrTrustlineNotFound, holder, currency)
+}
+
+// ListTrustlines returns every trust line of the account on the latest validated ledger,
+// paging through its account lines, such as to reconcile an account holding the same
+// currency from several issuers.
+//
+// Parameters:
+// - address: The account address whose lines are listed
+//
+// Returns the lines in ledger order, or an error if the lines cannot be read or a balance
+// or limit is malformed.
+func (b *Blockchain) ListTrustlines(address string) ([]TrustlineView, error) {
+	var views []TrustlineView
+	var marker any
+	for {
+		resp, err := b.c.GetAccountLines(&account.LinesRequest{
+			Account:     types.Address(address),
+			LedgerIndex: common.Validated,
+			Marker:      marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get account lines: %w", err)
+		}
+
+		for _, line := range resp.Lines {
+			balance, err := decimal.NewFromString(line.Balance)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trustline balance %q: %w", line.Balance, err)
+			}
+			limit, err := decimal.NewFromString(line.Limit)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trustline limit %q: %w", line.Limit, err)
+			}
+			views = append(views, TrustlineView{
+				Currency: line.Currency,
+				Issuer:   string(line.Account),
+				Balance:  balance,
+				Limit:    limit,
+			})
+		}
+		if resp.Marker == nil {
+			return views, nil
+		}
+		marker = resp.Marker
+	}
+}
+
+// DuplicateCurrencies reports the currencies held from more than one issuer.
+//
+// Parameters:
+// - lines: The trust lines of an account, as returned by ListTrustlines
+//
+// Returns the issuers of each duplicated currency in the order of lines, empty if none is.
+func DuplicateCurrencies(lines []TrustlineView) map[string][]string {
+	issuers := make(map[string][]string)
+	for _, line := range lines {
+		issuers[line.Currency] = append(issuers[line.Currency], line.Issuer)
+	}
+	for currency, list := range issuers {
+		if len(list) < 2 {
+			delete(issuers, currency)
+		}
+	}
+	return issuers
+}
+
+// GetTrustlineBalance returns the balance of the account's trustline for the currency with the
+// issuer, signed from the account's perspective: positive when the account holds the currency,
+// negative when it owes it, as for the issuer's side of a line or an account that went below zero.
+//
+// Parameters:
+// - account: The account address whose balance is read
+// - currency: The currency code (3-char or 40-char hex)
+// - issuer: The counterparty of the trustline, usually the issuer of the currency
+//
+// Returns the signed balance, zero if the trustline does not exist, or an error if
+// the lines cannot be read or the balance is malformed.
+func (b *Blockchain) GetTrustlineBalance(account, currency, issuer string) (decimal.Decimal, error) {
+	line, err := b.GetTrustline(account, currency, issuer)
+	if errors.Is(err, ErrTrustlineNotFound) {
+		return decimal.Zero, nil
+	}
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	balance, err := decimal.NewFromString(line.Balance)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid trustline balance %q: %w", line.Balance, err)
+	}
+	return balance, nil
+}
+
+// GetObligations returns the totals the issuer has issued and that are outstanding, per
+// currency, as reported by gateway_balances on the latest validated ledger. For the RLUSD
+// issuer this is the RLUSD held by all other accounts, to reconcile against the loans.
+//
+// Parameters:
+// - issuer: The issuer account address
+//
+// Returns the total issued by currency code (3-char or 40-char hex), empty if the issuer
+// has no obligations, or an error if the request fails or a total is malformed.
+func (b *Blockchain) GetObligations(issuer string) (map[string]decimal.Decimal, error) {
+	resp, err := b.c.GetGatewayBalances(&account.GatewayBalancesRequest{
+		Account:     types.Address(issuer),
+		LedgerIndex: common.Validated,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gateway balances: %w", err)
+	}
+
+	obligations := make(map[string]decimal.Decimal, len(resp.Obligations))
+	for currency, value := range resp.Obligations {
+		total, err := decimal.NewFromString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid obligation %q for currency %s: %w", value, currency, err)
+		}
+		obligations[currency] = total
+	}
+	return obligations, nil
+}
+
+// EnsureTrustlineCapacity makes sure the wallet's trustline can receive the required amount
+// of the currency on top of its current balance, raising the limit with a TrustSet when it
+// cannot. A limit set at provisioning may not cover interest accrued over a long loan,
+// and payments beyond it fail. A missing trustline is created with the required limit.
+//
+// Parameters:
+// - w: The wallet of the holder account
+// - currency: The currency code (3-char or 40-char hex)
+// - issuer: The issuer account address
+// - required: The amount the holder must be able to receive
+//
+// Returns an error if the trustline cannot be read or its limit cannot be raised.
+func (b *Blockchain) EnsureTrustlineCapacity(w *wallet.Wallet, currency, issuer string, required decimal.Decimal) error {
+	balance, limit := decimal.Zero, decimal.Zero
+	line, err := b.GetTrustline(w.ClassicAddress.String(), currency, issuer)
+	switch {
+	case errors.Is(err, ErrTrustlineNotFound):
+	case err != nil:
+		return err
+	default:
+		if balance, err = decimal.NewFromString(line.Balance); err != nil {
+			return fmt.Errorf("invalid trustline balance %q: %w", line.Balance, err)
+		}
+		if limit, err = decimal.NewFromString(line.Limit); err != nil {
+			return fmt.Errorf("invalid trustline limit %q: %w", line.Limit, err)
+		}
+	}
+
+	need := balance.Add(required)
+	if need.LessThanOrEqual(limit) {
+		return nil
+	}
+
+	value, err := NewIssuedAmount(need.String(), currency, issuer)
+	if err != nil {
+		return err
+	}
+	if _, err := b.SubmitTxAndWait(w, &transaction.TrustSet{LimitAmount: value}); err != nil {
+		return fmt.Errorf("failed to raise trustline limit to %s: %w", need, err)
+	}
+	return nil
+}
+
+// SetNoRippleOnLines sets NoRipple on the wallet's side of each trust line, so that issued
+// currency cannot ripple through the account, such as the issuer's side of the lines opened
+// at provisioning. The TrustSet of each line keeps a zero limit, as an issuer does not trust
+// its holders, and they are sent in Batch transactions of up to the configured batch size;
+// a single remaining line is sent as a plain TrustSet, as a Batch needs at least
+// MinBatchSize inner transactions. A line with an invalid currency or issuer is reported without being sent, and a failed
+// batch does not stop the following ones.
+//
+// Parameters:
+// - w: The wallet of the account owning its side of the lines
+// - lines: The trust lines to set NoRipple on
+//
+// Returns the result of each line in the order of lines, or an error wrapping
+// ErrInvalidBatchSize if there are no lines.
+func (b *Blockchain) SetNoRippleOnLines(w *wallet.Wallet, lines []TrustlineRef) ([]TrustlineResult, error) {
+	if w == nil {
+		return nil, fmt.Errorf("wallet cannot be nil")
+	}
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: no trust lines", ErrInvalidBatchSize)
+	}
+
+	results := make([]TrustlineResult, len(lines))
+	var pending []int
+	for i, line := range lines {
+		results[i].Line = line
+		if line.Issuer == w.ClassicAddress.String() {
+			results[i].Err = fmt.Errorf("%w: %q is the account itself", ErrInvalidAddress, line.Issuer)
+			continue
+		}
+		if _, err := NewIssuedAmount("0", line.Currency, line.Issuer); err != nil {
+			results[i].Err = err
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	for chunk := range slices.Chunk(pending, b.batchSize()) {
+		txs := make([]SubmittableTransaction, len(chunk))
+		for j, i := range chunk {
+			// Validated above
+			limit, _ := NewIssuedAmount("0", lines[i].Currency, lines[i].Issuer)
+			trustSet := &transaction.TrustSet{LimitAmount: limit}
+			trustSet.SetSetNoRippleFlag()
+			txs[j] = trustSet
+		}
+
+		var txHash string
+		var err error
+		if len(txs) == 1 {
+			txHash, err = b.SubmitTxAndWait(w, txs[0])
+		} else {
+			txHash, err = b.SubmitBatchAndWait(w, txs)
+		}
+		for _, i := range chunk {
+			if err != nil {
+				results[i].Err = fmt.Errorf("failed to set NoRipple: %w", err)
+				continue
+			}
+			results[i].TxHash = txHash
+		}
+	}
+	return results, nil
+}
+
+// Clawback reclaims issued currency from a holder's trustline back to the issuer.
+// The issuer account must have the lsfAllowTrustLineClawback flag set.
+//
+// Parameters:
+// - issuer: The issuer's wallet
+// - holder: The account address to claw back from
+// - amount: The amount to claw back, must be positive
+// - currency: The currency code (3-char or 40-char hex)
+//
+// Returns the transaction hash if successful, or an error if the clawback fails.
+func (b *Blockchain) Clawback(issuer *wallet.Wallet, holder string, amount decimal.Decimal, currency string) (string, error) {
+	if !amount.IsPositive() {
+		return "", fmt.Errorf("clawback amount must be positive, got %s", amount)
+	}
+
+	info, err := b.GetAccountInfo(issuer.ClassicAddress.String())
+	if err != nil {
+		return "", err
+	}
+	if info.AccountData.Flags&lsfAllowTrustLineClawback == 0 {
+		return "", ErrClawbackNotEnabled
+	}
+
+	ok, err := b.HasTrustline(holder, currency, issuer.ClassicAddress.String())
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", fmt.Errorf("%w: holder %s for currency %s", ErrTrustlineNotFound, holder, currency)
+	}
+
+	tx := &transaction.Clawback{
+		Amount: types.IssuedCurrencyAmount{
+			// For Clawback the issuer sub-field is the token holder
+			Issuer:   types.Address(holder),
+			Currency: currency,
+			Value:    amount.String(),
+		},
+	}
+
+	return b.SubmitTx(issuer, tx)
+}
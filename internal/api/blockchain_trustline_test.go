@@ -0,0 +1,169 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// tfSetNoRipple is the TrustSet flag setting NoRipple on the sender's side of the line.
+const tfSetNoRipple uint32 = 0x00020000
+
+// gatewayBalancesFixture is a gateway_balances result of an issuer with obligations in a
+// standard and a hex currency code, and assets and hot wallet balances that are not obligations.
+const gatewayBalancesFixture = `{
+	"account": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+	"assets": {
+		"rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE": [{"currency": "BTC", "value": "5"}]
+	},
+	"balances": {
+		"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe": [{"currency": "USD", "value": "25"}]
+	},
+	"ledger_hash": "4BC50C9B0D8515D3EAAE1E74B29A95804346C491EE1A95BF25E4AAB854A6A652",
+	"ledger_index": 200,
+	"obligations": {
+		"524C555344000000000000000000000000000000": "1100000.000001",
+		"USD": "5.5e3"
+	},
+	"validated": true
+}`
+
+func TestBlockchain_GetObligations(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"gateway_balances": fixtureResult(gatewayBalancesFixture),
+	})
+
+	obligations, err := bc.GetObligations(testAddress)
+	assert.NoError(t, err)
+	if assert.Len(t, obligations, 2) {
+		assert.Equal(t, "1100000.000001", obligations[RLUSDHex].String())
+		assert.Equal(t, "5500", obligations["USD"].String())
+	}
+	params := node.Params("gateway_balances")
+	if assert.Len(t, params, 1) {
+		assert.Equal(t, testAddress, params[0]["account"])
+		assert.Equal(t, "validated", params[0]["ledger_index"])
+	}
+
+	// An issuer with nothing outstanding has no obligations field
+	bc, _ = newTestBlockchain(t, map[string]rpcHandler{
+		"gateway_balances": func(params map[string]any) map[string]any {
+			return map[string]any{"account": testAddress, "validated": true}
+		},
+	})
+	obligations, err = bc.GetObligations(testAddress)
+	assert.NoError(t, err)
+	assert.Empty(t, obligations)
+
+	bc, _ = newTestBlockchain(t, map[string]rpcHandler{
+		"gateway_balances": func(params map[string]any) map[string]any {
+			return map[string]any{"obligations": map[string]any{"USD": "lots"}}
+		},
+	})
+	_, err = bc.GetObligations(testAddress)
+	assert.Error(t, err)
+}
+
+func TestBlockchain_SetNoRippleOnLines(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	assert.NoError(t, bc.SetMaxBatchSize(2))
+
+	lines := []TrustlineRef{
+		{Currency: RLUSDHex, Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+		{Currency: "USD", Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+		{Currency: "DOLLARS", Issuer: "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"},
+		{Currency: RLUSDHex, Issuer: testAddress},
+		{Currency: RLUSDHex, Issuer: "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"},
+	}
+	results, err := bc.SetNoRippleOnLines(bc.w, lines)
+	assert.NoError(t, err)
+	if !assert.Len(t, results, len(lines)) {
+		return
+	}
+
+	// The invalid currency and the line with the account itself are not sent
+	assert.True(t, errors.Is(results[2].Err, ErrInvalidCurrencyCode), "got %v", results[2].Err)
+	assert.True(t, errors.Is(results[3].Err, ErrInvalidAddress), "got %v", results[3].Err)
+	for _, i := range []int{0, 1, 4} {
+		assert.NoError(t, results[i].Err)
+		assert.NotEmpty(t, results[i].TxHash)
+	}
+	assert.Equal(t, results[0].TxHash, results[1].TxHash)
+	assert.NotEqual(t, results[0].TxHash, results[4].TxHash)
+
+	// The odd line left after the first batch is sent as a plain TrustSet
+	if !assert.Equal(t, 2, node.Calls("submit")) {
+		return
+	}
+	batch := submittedTx(t, node, 0)
+	assert.Equal(t, "Batch", batch["TransactionType"])
+	raw, _ := batch["RawTransactions"].([]any)
+	txs := make([]map[string]any, 0, len(raw)+1)
+	for _, entry := range raw {
+		inner := entry.(map[string]any)["RawTransaction"].(map[string]any)
+		flags, _ := inner["Flags"].(uint32)
+		assert.NotZero(t, flags&types.TfInnerBatchTxn)
+		txs = append(txs, inner)
+	}
+	single := submittedTx(t, node, 1)
+	flags, _ := single["Flags"].(uint32)
+	assert.Zero(t, flags&types.TfInnerBatchTxn)
+	txs = append(txs, single)
+
+	var sent []TrustlineRef
+	for _, tx := range txs {
+		assert.Equal(t, "TrustSet", tx["TransactionType"])
+		flags, _ := tx["Flags"].(uint32)
+		assert.NotZero(t, flags&tfSetNoRipple, "NoRipple is set on every line")
+		limit := tx["LimitAmount"].(map[string]any)
+		assert.Equal(t, "0", limit["value"])
+		sent = append(sent, TrustlineRef{Currency: limit["currency"].(string), Issuer: limit["issuer"].(string)})
+	}
+	assert.Equal(t, []TrustlineRef{lines[0], lines[1], lines[4]}, sent)
+
+	_, err = bc.SetNoRippleOnLines(bc.w, nil)
+	assert.True(t, errors.Is(err, ErrInvalidBatchSize))
+}
+
+func TestBlockchain_ListTrustlines(t *testing.T) {
+	pages := map[string]map[string]any{
+		"": {
+			"account": testAddress,
+			"lines": []any{
+				map[string]any{"account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "currency": RLUSDHex, "balance": "150.5", "limit": "1000", "limit_peer": "0"},
+				map[string]any{"account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "currency": "USD", "balance": "-2", "limit": "0", "limit_peer": "10"},
+			},
+			"marker": "page2",
+		},
+		"page2": {
+			"account": testAddress,
+			"lines": []any{
+				map[string]any{"account": "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE", "currency": RLUSDHex, "balance": "0", "limit": "500", "limit_peer": "0"},
+			},
+		},
+	}
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"account_lines": func(params map[string]any) map[string]any {
+			marker, _ := params["marker"].(string)
+			return pages[marker]
+		},
+	})
+
+	lines, err := bc.ListTrustlines(testAddress)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, node.Calls("account_lines"))
+	if assert.Len(t, lines, 3) {
+		assert.Equal(t, RLUSDHex, lines[0].Currency)
+		assert.Equal(t, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", lines[0].Issuer)
+		assert.Equal(t, "150.5", lines[0].Balance.String())
+		assert.Equal(t, "1000", lines[0].Limit.String())
+		assert.Equal(t, "-2", lines[1].Balance.String())
+		assert.Equal(t, "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE", lines[2].Issuer)
+	}
+
+	assert.Equal(t, map[string][]string{
+		RLUSDHex: {"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"},
+	}, DuplicateCurrencies(lines))
+}
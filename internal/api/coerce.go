@@ -0,0 +1,154 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var (
+	// ErrInvalidNumber is returned when a JSON value cannot be coerced to the expected numeric type.
+	ErrInvalidNumber = errors.New("invalid number")
+)
+
+// coerceToUint64 converts a numeric value decoded from a node response to uint64.
+// Depending on the decoder, numbers arrive as float64, json.Number, integer types or,
+// for amounts and 64-bit fields, as decimal strings.
+//
+// Returns the value, or an error wrapping ErrInvalidNumber if it is not a non-negative integer.
+func coerceToUint64(v any) (uint64, error) {
+	switch n := v.(type) {
+	case uint64:
+		return n, nil
+	case uint32:
+		return uint64(n), nil
+	case uint:
+		return uint64(n), nil
+	case int:
+		return intToUint64(int64(n))
+	case int32:
+		return intToUint64(int64(n))
+	case int64:
+		return intToUint64(n)
+	case float32:
+		return floatToUint64(float64(n))
+	case float64:
+		return floatToUint64(n)
+	case json.Number:
+		return parseUint64(n.String())
+	case string:
+		return parseUint64(n)
+	default:
+		return 0, fmt.Errorf("%w: unexpected type %T", ErrInvalidNumber, v)
+	}
+}
+
+// FeeFromAny converts a transaction Fee to drops, whether it is a typed
+// types.XRPCurrencyAmount or a value decoded from a node response, where the fee is
+// a decimal string of drops or, depending on the decoder, a number.
+//
+// Parameters:
+// - v: The fee
+//
+// Returns the fee in drops, or an error wrapping ErrInvalidNumber if it is not a non-negative integer.
+func FeeFromAny(v any) (uint64, error) {
+	if fee, ok := v.(types.XRPCurrencyAmount); ok {
+		return uint64(fee), nil
+	}
+	return coerceToUint64(v)
+}
+
+// coerceToFloat64 converts a numeric value decoded from a node response to float64,
+// accepting the same representations as coerceToUint64.
+//
+// Returns the value, or an error wrapping ErrInvalidNumber if it is not a finite number.
+func coerceToFloat64(v any) (float64, error) {
+	var f float64
+	switch n := v.(type) {
+	case float64:
+		f = n
+	case float32:
+		f = float64(n)
+	case uint64:
+		f = float64(n)
+	case uint32:
+		f = float64(n)
+	case uint:
+		f = float64(n)
+	case int:
+		f = float64(n)
+	case int32:
+		f = float64(n)
+	case int64:
+		f = float64(n)
+	case json.Number:
+		return parseFloat64(n.String())
+	case string:
+		return parseFloat64(n)
+	default:
+		return 0, fmt.Errorf("%w: unexpected type %T", ErrInvalidNumber, v)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: %v is not finite", ErrInvalidNumber, f)
+	}
+	return f, nil
+}
+
+func intToUint64(n int64) (uint64, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("%w: %d is negative", ErrInvalidNumber, n)
+	}
+	return uint64(n), nil
+}
+
+func floatToUint64(f float64) (uint64, error) {
+	if f < 0 || f != math.Trunc(f) || f >= math.MaxUint64 {
+		return 0, fmt.Errorf("%w: %v is not an unsigned integer", ErrInvalidNumber, f)
+	}
+	return uint64(f), nil
+}
+
+func parseUint64(s string) (uint64, error) {
+	u, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q: %v", ErrInvalidNumber, s, err)
+	}
+	return u, nil
+}
+
+func parseFloat64(s string) (float64, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q: %v", ErrInvalidNumber, s, err)
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("%w: %q is not finite", ErrInvalidNumber, s)
+	}
+	return f, nil
+}
+
+// uint32Field reads a 32-bit unsigned field of a transaction returned by the node.
+// A missing field is zero unless it is required.
+func uint32Field(tx transactions.FlatTransaction, field string, required bool) (uint32, error) {
+	v, ok := tx[field]
+	if !ok || v == nil {
+		if required {
+			return 0, fmt.Errorf("%s is required but was not found", field)
+		}
+		return 0, nil
+	}
+
+	u, err := coerceToUint64(v)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %w", field, err)
+	}
+	if u > math.MaxUint32 {
+		return 0, fmt.Errorf("failed to parse %s: %w: %d overflows uint32", field, ErrInvalidNumber, u)
+	}
+	return uint32(u), nil
+}
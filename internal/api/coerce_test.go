@@ -0,0 +1,238 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCoerceToUint64(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    uint64
+		wantErr bool
+	}{
+		{name: "uint64", value: uint64(math.MaxUint64), want: math.MaxUint64},
+		{name: "uint32", value: uint32(7), want: 7},
+		{name: "uint", value: uint(7), want: 7},
+		{name: "int", value: 7, want: 7},
+		{name: "int32", value: int32(7), want: 7},
+		{name: "int64", value: int64(7), want: 7},
+		{name: "float32", value: float32(7), want: 7},
+		{name: "float64", value: float64(131072), want: 131072},
+		{name: "json.Number", value: json.Number("12"), want: 12},
+		{name: "large json.Number", value: json.Number("18446744073709551615"), want: math.MaxUint64},
+		{name: "string", value: "12", want: 12},
+		{name: "negative int", value: -1, wantErr: true},
+		{name: "negative int64", value: int64(-1), wantErr: true},
+		{name: "fractional float64", value: 1.5, wantErr: true},
+		{name: "negative float64", value: float64(-2), wantErr: true},
+		{name: "NaN", value: math.NaN(), wantErr: true},
+		{name: "decimal json.Number", value: json.Number("1.5"), wantErr: true},
+		{name: "negative string", value: "-12", wantErr: true},
+		{name: "empty string", value: "", wantErr: true},
+		{name: "nil", value: nil, wantErr: true},
+		{name: "bool", value: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := coerceToUint64(tt.value)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidNumber), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFeeFromAny(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    uint64
+		wantErr bool
+	}{
+		{name: "XRPCurrencyAmount", value: types.XRPCurrencyAmount(12), want: 12},
+		{name: "large XRPCurrencyAmount", value: types.XRPCurrencyAmount(math.MaxUint64), want: math.MaxUint64},
+		{name: "string", value: "12", want: 12},
+		{name: "json.Number", value: json.Number("5000"), want: 5000},
+		{name: "float64", value: float64(12), want: 12},
+		{name: "decimal string", value: "0.000012", wantErr: true},
+		{name: "negative json.Number", value: json.Number("-12"), wantErr: true},
+		{name: "nil", value: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FeeFromAny(tt.value)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidNumber), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCoerceToFloat64(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   any
+		want    float64
+		wantErr bool
+	}{
+		{name: "float64", value: 1.5, want: 1.5},
+		{name: "float32", value: float32(0.5), want: 0.5},
+		{name: "uint64", value: uint64(7), want: 7},
+		{name: "uint32", value: uint32(7), want: 7},
+		{name: "uint", value: uint(7), want: 7},
+		{name: "int", value: -7, want: -7},
+		{name: "int32", value: int32(-7), want: -7},
+		{name: "int64", value: int64(-7), want: -7},
+		{name: "json.Number", value: json.Number("40.25"), want: 40.25},
+		{name: "string", value: "1e3", want: 1000},
+		{name: "infinite float64", value: math.Inf(1), wantErr: true},
+		{name: "NaN string", value: "NaN", wantErr: true},
+		{name: "malformed json.Number", value: json.Number("1.2.3"), wantErr: true},
+		{name: "malformed string", value: "ten", wantErr: true},
+		{name: "nil", value: nil, wantErr: true},
+		{name: "map", value: map[string]any{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := coerceToFloat64(tt.value)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidNumber), "got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUint32Field(t *testing.T) {
+	tx := transactions.FlatTransaction{
+		"Flags":              json.Number("131072"),
+		"LastLedgerSequence": "56865248",
+		"Sequence":           float64(2),
+		"Expiration":         float64(math.MaxUint32 + 1),
+	}
+
+	flags, err := uint32Field(tx, "Flags", false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(131072), flags)
+
+	lastLedger, err := uint32Field(tx, "LastLedgerSequence", false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(56865248), lastLedger)
+
+	sequence, err := uint32Field(tx, "Sequence", true)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), sequence)
+
+	missing, err := uint32Field(tx, "SourceTag", false)
+	assert.NoError(t, err)
+	assert.Zero(t, missing)
+
+	_, err = uint32Field(tx, "TicketSequence", true)
+	assert.Error(t, err)
+
+	_, err = uint32Field(tx, "Expiration", false)
+	assert.True(t, errors.Is(err, ErrInvalidNumber), "got %v", err)
+}
+
+func TestUint32Field_Representations(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     any
+		want    uint32
+		wantErr bool
+	}{
+		{name: "json.Number", raw: json.Number("42"), want: 42},
+		{name: "float64", raw: float64(42), want: 42},
+		{name: "float32", raw: float32(42), want: 42},
+		{name: "int", raw: 42, want: 42},
+		{name: "int32", raw: int32(42), want: 42},
+		{name: "int64", raw: int64(42), want: 42},
+		{name: "uint", raw: uint(42), want: 42},
+		{name: "uint32", raw: uint32(42), want: 42},
+		{name: "uint64", raw: uint64(42), want: 42},
+		{name: "string", raw: "42", want: 42},
+		{name: "max", raw: json.Number("4294967295"), want: math.MaxUint32},
+		{name: "overflow", raw: uint64(math.MaxUint32 + 1), wantErr: true},
+		{name: "negative", raw: -1, wantErr: true},
+		{name: "fraction", raw: 4.2, wantErr: true},
+		{name: "bool", raw: true, wantErr: true},
+		{name: "missing", raw: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uint32Field(map[string]any{"Sequence": tt.raw}, "Sequence", true)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUint32Field_Decoded(t *testing.T) {
+	const raw = `{"Sequence": 42}`
+
+	var plain map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(raw), &plain))
+
+	dec := json.NewDecoder(strings.NewReader(raw))
+	dec.UseNumber()
+	var numbers map[string]any
+	assert.NoError(t, dec.Decode(&numbers))
+
+	for _, tx := range []map[string]any{plain, numbers} {
+		sequence, err := uint32Field(tx, "Sequence", true)
+		assert.NoError(t, err)
+		assert.Equal(t, uint32(42), sequence)
+	}
+}
+
+func TestDeliveredAmount_NumericAmounts(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  any
+		want types.CurrencyAmount
+	}{
+		{name: "XRP float64", raw: float64(1000000), want: types.XRPCurrencyAmount(1000000)},
+		{name: "XRP json.Number", raw: json.Number("1000000"), want: types.XRPCurrencyAmount(1000000)},
+		{
+			name: "issued json.Number value",
+			raw:  map[string]any{"currency": "USD", "issuer": testAddress, "value": json.Number("40.5")},
+			want: types.IssuedCurrencyAmount{Currency: "USD", Issuer: types.Address(testAddress), Value: "40.5"},
+		},
+		{
+			name: "issued float64 value",
+			raw:  map[string]any{"currency": "USD", "issuer": testAddress, "value": float64(40)},
+			want: types.IssuedCurrencyAmount{Currency: "USD", Issuer: types.Address(testAddress), Value: "40"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, err := DeliveredAmount(transactions.TxObjMeta{DeliveredAmount: tt.raw})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, amount)
+		})
+	}
+
+	_, err := DeliveredAmount(transactions.TxObjMeta{DeliveredAmount: float64(-1)})
+	assert.True(t, errors.Is(err, ErrInvalidNumber), "got %v", err)
+}
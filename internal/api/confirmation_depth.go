@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+)
+
+const (
+	// DefaultConfirmationDepth treats a transaction as final once its ledger is validated.
+	DefaultConfirmationDepth = 1
+	// confirmationPollsPerLedger bounds how long AwaitConfirmationDepth polls for each
+	// ledger of depth still missing before giving up.
+	confirmationPollsPerLedger = 4
+)
+
+var (
+	// ErrConfirmationDepth is returned when a transaction does not reach the confirmation depth in time.
+	ErrConfirmationDepth = errors.New("confirmation depth not reached")
+)
+
+// SetConfirmationDepth sets how many validated ledgers, counting the transaction's own,
+// a transaction needs before it is reported final. A depth of 1 treats a transaction as
+// final once its ledger is validated; each extra ledger guards against consumers acting
+// on a ledger they have not yet seen.
+//
+// Parameters:
+// - depth: The confirmation depth, zero uses DefaultConfirmationDepth
+func (b *Blockchain) SetConfirmationDepth(depth uint32) {
+	if depth == 0 {
+		depth = DefaultConfirmationDepth
+	}
+	b.confirmationDepth = depth
+}
+
+// depth returns the configured confirmation depth.
+func (b *Blockchain) depth() uint32 {
+	if b.confirmationDepth == 0 {
+		return DefaultConfirmationDepth
+	}
+	return b.confirmationDepth
+}
+
+// Confirmations returns how many validated ledgers a transaction of the given ledger has,
+// counting its own ledger, from the latest validated ledger of the node.
+//
+// Parameters:
+// - ledgerIndex: The index of the ledger holding the transaction
+//
+// Returns the confirmation count, zero if the ledger is not validated yet,
+// or an error if the ledger index cannot be read.
+func (b *Blockchain) Confirmations(ledgerIndex uint32) (uint32, error) {
+	validated, err := b.c.GetLedgerIndex()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ledger index: %w", err)
+	}
+	if validated.Uint32() < ledgerIndex {
+		return 0, nil
+	}
+	return validated.Uint32() - ledgerIndex + 1, nil
+}
+
+// IsFinal reports whether a transaction is validated and deep enough to meet the
+// confirmation depth. With the default depth no further request is made.
+//
+// Parameters:
+// - resp: The transaction response, as returned by GetTransactionInfo
+//
+// Returns whether the transaction is final, or an error if the ledger index cannot be read.
+func (b *Blockchain) IsFinal(resp *requests.TxResponse) (bool, error) {
+	if resp == nil || !resp.Validated {
+		return false, nil
+	}
+	if b.depth() <= DefaultConfirmationDepth {
+		return true, nil
+	}
+
+	confirmations, err := b.Confirmations(resp.LedgerIndex.Uint32())
+	if err != nil {
+		return false, err
+	}
+	return confirmations >= b.depth(), nil
+}
+
+// AwaitConfirmationDepth waits until a validated transaction of the given ledger meets
+// the confirmation depth, polling the node about once a ledger close until the context
+// is done.
+//
+// Parameters:
+// - ctx: Bounds the wait
+// - ledgerIndex: The index of the ledger holding the transaction
+//
+// Returns nil once the depth is met, an error wrapping ErrConfirmationDepth if the
+// node's validated ledger stops advancing, an error wrapping the context error if the
+// context is done first, or an error if the ledger index cannot be read.
+func (b *Blockchain) AwaitConfirmationDepth(ctx context.Context, ledgerIndex uint32) error {
+	interval := b.watchInterval
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	depth := b.depth()
+	for polls := 0; ; polls++ {
+		confirmations, err := b.Confirmations(ledgerIndex)
+		if err != nil {
+			return err
+		}
+		if confirmations >= depth {
+			return nil
+		}
+		if polls >= int(depth)*confirmationPollsPerLedger {
+			return fmt.Errorf("%w: ledger %d has %d of %d confirmations",
+				ErrConfirmationDepth, ledgerIndex, confirmations, depth)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("ledger %d has %d of %d confirmations: %w", ledgerIndex, confirmations, depth, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	tokenv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/token/v1"
+)
+
+// mptAuthorizeLedger is the ledger holding the transaction of mptAuthorizeTxFixture.
+const mptAuthorizeLedger = 56865245
+
+// validatedLedgerResult returns a ledger handler reporting the given validated ledger index.
+func validatedLedgerResult(index *atomic.Uint32) rpcHandler {
+	return func(params map[string]any) map[string]any {
+		return map[string]any{"ledger_index": index.Load(), "validated": true}
+	}
+}
+
+func TestToken_TransactionInfo_ConfirmationDepth(t *testing.T) {
+	var validated atomic.Uint32
+	// The transaction's own ledger is the latest validated one: one ledger deep
+	validated.Store(mptAuthorizeLedger)
+
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"tx":     fixtureResult(mptAuthorizeTxFixture),
+		"ledger": validatedLedgerResult(&validated),
+	})
+	tokenAPI := createTestToken(bc)
+	req := &tokenv1.TransactionInfoRequest{
+		TransactionId: "E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7",
+	}
+
+	resp, err := tokenAPI.TransactionInfo(context.Background(), req)
+	if assert.NoError(t, err) {
+		assert.True(t, resp.GetTransaction().GetIsSuccess(), "default depth treats a validated transaction as final")
+		assert.True(t, resp.GetTransaction().GetFullyConfirmed())
+	}
+
+	bc.SetConfirmationDepth(3)
+	resp, err = tokenAPI.TransactionInfo(context.Background(), req)
+	if assert.NoError(t, err) {
+		assert.False(t, resp.GetTransaction().GetIsSuccess())
+		assert.False(t, resp.GetTransaction().GetFullyConfirmed())
+	}
+
+	validated.Store(mptAuthorizeLedger + 2)
+	resp, err = tokenAPI.TransactionInfo(context.Background(), req)
+	if assert.NoError(t, err) {
+		assert.True(t, resp.GetTransaction().GetIsSuccess())
+		assert.True(t, resp.GetTransaction().GetFullyConfirmed())
+	}
+}
+
+func TestBlockchain_AwaitConfirmationDepth(t *testing.T) {
+	var validated atomic.Uint32
+	validated.Store(mptAuthorizeLedger)
+
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger": func(params map[string]any) map[string]any {
+			// Every poll sees the next ledger validated
+			return map[string]any{"ledger_index": validated.Add(1) - 1, "validated": true}
+		},
+	})
+	bc.SetWatchInterval(time.Millisecond)
+	bc.SetConfirmationDepth(3)
+
+	assert.NoError(t, bc.AwaitConfirmationDepth(context.Background(), mptAuthorizeLedger))
+	assert.Equal(t, 3, node.Calls("ledger"))
+
+	// A node whose validated ledger stops advancing
+	bc, _ = newTestBlockchain(t, map[string]rpcHandler{
+		"ledger": validatedLedgerResult(&validated),
+	})
+	bc.SetWatchInterval(time.Millisecond)
+	bc.SetConfirmationDepth(3)
+
+	err := bc.AwaitConfirmationDepth(context.Background(), validated.Load())
+	assert.True(t, errors.Is(err, ErrConfirmationDepth), "got %v", err)
+
+	// The wait ends with the context instead of sleeping through the polls
+	bc.SetWatchInterval(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = bc.AwaitConfirmationDepth(ctx, validated.Load())
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestToken_TransactionInfo_DefaultDepthNotValidated(t *testing.T) {
+	// A successful transaction whose ledger is not validated yet
+	tx := fixtureResult(mptAuthorizeTxFixture)
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"tx": func(params map[string]any) map[string]any {
+			result := tx(params)
+			result["validated"] = false
+			return result
+		},
+	})
+	tokenAPI := createTestToken(bc)
+
+	resp, err := tokenAPI.TransactionInfo(context.Background(), &tokenv1.TransactionInfoRequest{
+		TransactionId: "E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7",
+	})
+	if assert.NoError(t, err) {
+		assert.False(t, resp.GetTransaction().GetIsSuccess())
+		assert.True(t, resp.GetTransaction().GetFullyConfirmed(), "the default depth reports the result alone")
+	}
+}
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// CorrelationIDHeader is the gRPC metadata key carrying the correlation ID of a request.
+	CorrelationIDHeader = "x-correlation-id"
+
+	// maxCorrelationIDLength bounds a client supplied correlation ID; longer ones are replaced.
+	maxCorrelationIDLength = 128
+
+	// correlationMemoType is the MemoType of the correlation memo, hex encoded as the ledger requires.
+	correlationMemoType = "636F7272656C6174696F6E5F6964" // "correlation_id"
+)
+
+type correlationIDKey struct{}
+
+// ContextWithCorrelationID returns a copy of ctx carrying the correlation ID.
+func ContextWithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the correlation ID of a request: the one bound to the context by
+// the correlation interceptors, else the one in the incoming CorrelationIDHeader metadata,
+// else a newly generated one. Handlers call it once and reuse the result.
+func CorrelationID(ctx context.Context) string {
+	if id, ok := ctx.Value(correlationIDKey{}).(string); ok && id != "" {
+		return id
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, id := range md.Get(CorrelationIDHeader) {
+			if id = strings.TrimSpace(id); id != "" && len(id) <= maxCorrelationIDLength {
+				return id
+			}
+		}
+	}
+	return newCorrelationID()
+}
+
+// newCorrelationID generates a random 128-bit correlation ID.
+func newCorrelationID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// UnaryCorrelationInterceptor binds the correlation ID of every unary request to its context
+// and returns it to the client in the CorrelationIDHeader response header.
+func UnaryCorrelationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		id := CorrelationID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(CorrelationIDHeader, id))
+		return handler(ContextWithCorrelationID(ctx, id), req)
+	}
+}
+
+// StreamCorrelationInterceptor binds the correlation ID of every stream to its context
+// and returns it to the client in the CorrelationIDHeader response header.
+func StreamCorrelationInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		id := CorrelationID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(CorrelationIDHeader, id))
+		return handler(srv, &correlatedStream{ServerStream: ss, ctx: ContextWithCorrelationID(ss.Context(), id)})
+	}
+}
+
+// correlatedStream is a server stream whose context carries the correlation ID.
+type correlatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *correlatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// EnableCorrelationMemo makes every submitted transaction carry a memo with the SHA-256
+// of the correlation ID set by SetCorrelationID. The ID is hashed so that internal request
+// identifiers are not published on the ledger, while still matching the service logs.
+//
+// Parameters:
+// - enabled: Whether transactions carry the correlation memo
+func (b *Blockchain) EnableCorrelationMemo(enabled bool) {
+	b.correlationMemo = enabled
+}
+
+// addCorrelationMemo appends the correlation memo to the transaction when it is enabled
+// and a correlation ID is set.
+func (b *Blockchain) addCorrelationMemo(tx transactions.FlatTransaction) {
+	if !b.correlationMemo || b.correlationID == "" {
+		return
+	}
+
+	sum := sha256.Sum256([]byte(b.correlationID))
+	memos, _ := tx["Memos"].([]any)
+	tx["Memos"] = append(memos, map[string]any{
+		"Memo": map[string]any{
+			"MemoType": correlationMemoType,
+			"MemoData": strings.ToUpper(hex.EncodeToString(sum[:])),
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+	tokenv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/token/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCorrelationID(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CorrelationIDHeader, "req-42"))
+	assert.Equal(t, "req-42", CorrelationID(ctx))
+
+	// The ID bound to the context wins over the metadata
+	assert.Equal(t, "bound", CorrelationID(ContextWithCorrelationID(ctx, "bound")))
+
+	// Without one, or with an oversized one, an ID is generated
+	generated := CorrelationID(context.Background())
+	assert.Len(t, generated, 32)
+	assert.NotEqual(t, generated, CorrelationID(context.Background()))
+
+	oversized := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CorrelationIDHeader, strings.Repeat("a", 129)))
+	assert.Len(t, CorrelationID(oversized), 32)
+}
+
+func TestUnaryCorrelationInterceptor(t *testing.T) {
+	interceptor := UnaryCorrelationInterceptor()
+
+	var seen []string
+	handler := func(ctx context.Context, req any) (any, error) {
+		seen = append(seen, CorrelationID(ctx), CorrelationID(ctx))
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(CorrelationIDHeader, "req-42"))
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+
+	if assert.Len(t, seen, 4) {
+		assert.Equal(t, []string{"req-42", "req-42"}, seen[:2])
+		// A generated ID is stable for the whole request
+		assert.Equal(t, seen[2], seen[3])
+	}
+}
+
+func TestToken_CorrelationIDLogged(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	tokenAPI := NewToken(logger, nil, &config.FeatureConfig{})
+
+	ctx := ContextWithCorrelationID(context.Background(), "req-42")
+	tokenID := "not-an-issuance-id"
+	_, err := tokenAPI.Transfer(ctx, &tokenv1.TransferRequest{TokenId: &tokenID})
+	assert.Error(t, err)
+
+	var record map[string]any
+	if assert.NoError(t, json.Unmarshal(buf.Bytes(), &record)) {
+		assert.Equal(t, "Transfer", record["method"])
+		assert.Equal(t, "req-42", record["correlation_id"])
+	}
+}
+
+func TestBlockchain_SubmitTx_CorrelationMemo(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	bc.Lock()
+	bc.SetCorrelationID("req-42")
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+
+	bc.EnableCorrelationMemo(true)
+	_, err = bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	bc.Unlock()
+
+	// Disabled by default
+	assert.NotContains(t, submittedTx(t, node, 0), "Memos")
+
+	sum := sha256.Sum256([]byte("req-42"))
+	memos, ok := submittedTx(t, node, 1)["Memos"].([]any)
+	if assert.True(t, ok) && assert.Len(t, memos, 1) {
+		memo := memos[0].(map[string]any)["Memo"].(map[string]any)
+		assert.Equal(t, strings.ToUpper(hex.EncodeToString([]byte("correlation_id"))), memo["MemoType"])
+		assert.Equal(t, strings.ToUpper(hex.EncodeToString(sum[:])), memo["MemoData"])
+	}
+}
+
+func TestBlockchain_SubmitTx_CorrelationMemoWithoutID(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.EnableCorrelationMemo(true)
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.NotContains(t, submittedTx(t, node, 0), "Memos")
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// deliveredAmountUnavailable is reported by the node for payments
+// validated before delivered amounts were tracked.
+const deliveredAmountUnavailable = "unavailable"
+
+// DeliveredAmount returns the amount actually delivered by a payment.
+// For partial payments this can be less than the Amount field of the transaction.
+// DeliveredAmount is read first, falling back to delivered_amount.
+//
+// Parameters:
+// - meta: The transaction metadata
+//
+// Returns the delivered XRP, issued currency or MPT amount, nil if the metadata
+// carries none, or an error if the amount cannot be parsed.
+func DeliveredAmount(meta transactions.TxObjMeta) (types.CurrencyAmount, error) {
+	for _, raw := range []any{meta.PartialDeliveredAmount, meta.DeliveredAmount} {
+		switch v := raw.(type) {
+		case nil:
+			continue
+		case types.CurrencyAmount:
+			return v, nil
+		case string:
+			if v == deliveredAmountUnavailable {
+				continue
+			}
+		}
+
+		raw, err := normalizeAmount(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse delivered amount: %w", err)
+		}
+		if xrp, ok := raw.(types.XRPCurrencyAmount); ok {
+			return xrp, nil
+		}
+
+		data, err := json.Marshal(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal delivered amount: %w", err)
+		}
+		amount, err := types.UnmarshalCurrencyAmount(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse delivered amount: %w", err)
+		}
+		return amount, nil
+	}
+
+	return nil, nil
+}
+
+// normalizeAmount converts the numeric forms an amount can be decoded into to the ones
+// types.UnmarshalCurrencyAmount expects: XRP drops given as a number become an XRPCurrencyAmount
+// and an issued currency value given as a number becomes a decimal string.
+func normalizeAmount(raw any) (any, error) {
+	switch v := raw.(type) {
+	case string, types.CurrencyAmount:
+		return raw, nil
+	case map[string]any:
+		value, ok := v["value"]
+		if !ok {
+			return raw, nil
+		}
+		if _, ok := value.(string); ok {
+			return raw, nil
+		}
+		f, err := coerceToFloat64(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value: %w", err)
+		}
+		normalized := make(map[string]any, len(v))
+		for k, field := range v {
+			normalized[k] = field
+		}
+		normalized["value"] = strconv.FormatFloat(f, 'f', -1, 64)
+		return normalized, nil
+	default:
+		drops, err := coerceToUint64(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid XRP amount: %w", err)
+		}
+		return types.XRPCurrencyAmount(drops), nil
+	}
+}
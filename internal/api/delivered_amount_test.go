@@ -0,0 +1,107 @@
+package api
+
+import (
+	"testing"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// partialPaymentTxFixture is a tx result for a partial payment that delivered 40 of 100 USD.
+const partialPaymentTxFixture = `{
+	"hash": "C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB1133",
+	"ledger_index": 56865245,
+	"date": 750000000,
+	"validated": true,
+	"meta": {
+		"AffectedNodes": [],
+		"DeliveredAmount": {
+			"currency": "USD",
+			"issuer": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+			"value": "40"
+		},
+		"TransactionIndex": 3,
+		"TransactionResult": "tesSUCCESS",
+		"delivered_amount": {
+			"currency": "USD",
+			"issuer": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+			"value": "40"
+		}
+	},
+	"tx_json": {
+		"Account": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		"Amount": {
+			"currency": "USD",
+			"issuer": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+			"value": "100"
+		},
+		"Destination": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+		"Fee": "12",
+		"Flags": 131072,
+		"LastLedgerSequence": 56865248,
+		"Sequence": 2,
+		"SigningPubKey": "03AB40A0490F9B7ED8DF29D246BF2D6269820A0EE7742ACDD457BEA7C7D0931EDB",
+		"TransactionType": "Payment",
+		"TxnSignature": "3045022100"
+	}
+}`
+
+func TestBlockchain_GetTransactionInfo_PartialPayment(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"tx": fixtureResult(partialPaymentTxFixture),
+	})
+
+	resp, meta, _, err := bc.GetTransactionInfo("C53ECF838647FA5A4C780377025FEC7999AB4182590510CA461444B207AB1133")
+	if err != nil {
+		t.Fatalf("failed to get transaction info: %v", err)
+	}
+
+	delivered, ok := meta.DeliveredAmount.(types.IssuedCurrencyAmount)
+	if !assert.True(t, ok, "got %T", meta.DeliveredAmount) {
+		return
+	}
+	sent := resp.TxJson["Amount"].(map[string]any)
+	assert.Equal(t, "USD", delivered.Currency)
+	assert.True(t, decimal.RequireFromString(delivered.Value).LessThan(decimal.RequireFromString(sent["value"].(string))))
+}
+
+func TestDeliveredAmount(t *testing.T) {
+	tests := []struct {
+		name string
+		meta transactions.TxObjMeta
+		want types.CurrencyAmount
+	}{
+		{
+			name: "xrp",
+			meta: transactions.TxObjMeta{PartialDeliveredAmount: "1000"},
+			want: types.XRPCurrencyAmount(1000),
+		},
+		{
+			name: "mpt falls back to delivered_amount",
+			meta: transactions.TxObjMeta{DeliveredAmount: map[string]any{
+				"mpt_issuance_id": "0000000A" + "CB11F2B6A2E2A1A84F7F6D6F7E7A2B0F1C4D1C3A",
+				"value":           "1",
+			}},
+			want: types.MPTCurrencyAmount{MPTIssuanceID: "0000000ACB11F2B6A2E2A1A84F7F6D6F7E7A2B0F1C4D1C3A", Value: "1"},
+		},
+		{
+			name: "unavailable",
+			meta: transactions.TxObjMeta{DeliveredAmount: "unavailable"},
+			want: nil,
+		},
+		{
+			name: "absent",
+			meta: transactions.TxObjMeta{},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DeliveredAmount(tt.meta)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// engineResultPrefix prefixes the message of a non-tesSUCCESS engine result, as the rpc client reports it.
+const engineResultPrefix = "transaction failed to submit with engine result: "
+
+// EngineError is returned when the node does not apply a submitted transaction.
+// Use errors.As to inspect the engine result; transport failures are reported as *rpc.ClientError instead.
+type EngineError struct {
+	Result        transactions.TxResult
+	ResultMessage string
+}
+
+func (e *EngineError) Error() string {
+	if e.ResultMessage == "" {
+		return engineResultPrefix + string(e.Result)
+	}
+	return fmt.Sprintf("%s%s: %s", engineResultPrefix, e.Result, e.ResultMessage)
+}
+
+// Is reports whether the target is an EngineError with the same result,
+// so errors.Is(err, &EngineError{Result: transactions.TecNO_AUTH}) matches regardless of the message.
+func (e *EngineError) Is(target error) bool {
+	t, ok := target.(*EngineError)
+	return ok && t.Result == e.Result
+}
+
+// isRPCError reports whether err is, or wraps, the error a node answered a request with,
+// such as actNotFound or txnNotFound. The rpc client reports it as an *rpc.ClientError
+// holding the error code.
+func isRPCError(err error, code string) bool {
+	var clientErr *rpc.ClientError
+	return errors.As(err, &clientErr) && clientErr.ErrorString == code
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_SubmitTx_EngineError(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tecNO_AUTH"))
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	var engineErr *EngineError
+	if assert.True(t, errors.As(err, &engineErr)) {
+		assert.Equal(t, transaction.TecNO_AUTH, engineErr.Result)
+		assert.NotEmpty(t, engineErr.ResultMessage)
+	}
+
+	_, _, err = bc.SubmitTxWithSequence(bc.w, &transaction.AccountSet{})
+	assert.True(t, errors.Is(err, &EngineError{Result: transaction.TecNO_AUTH}))
+	assert.False(t, errors.Is(err, &EngineError{Result: transaction.TecUNFUNDED_PAYMENT}))
+}
+
+func TestBlockchain_SubmitTxAndWait_EngineError(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tefPAST_SEQ"))
+
+	_, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	var engineErr *EngineError
+	if assert.True(t, errors.As(err, &engineErr)) {
+		assert.Equal(t, transaction.TefPAST_SEQ, engineErr.Result)
+	}
+}
+
+func TestIsRPCError(t *testing.T) {
+	err := fmt.Errorf("failed to get account info: %w", &rpc.ClientError{ErrorString: "actNotFound"})
+	assert.True(t, isRPCError(err, "actNotFound"))
+	assert.False(t, isRPCError(err, "txnNotFound"))
+
+	// Only the code the node answered with matches, not a message mentioning it
+	assert.False(t, isRPCError(errors.New("actNotFound"), "actNotFound"))
+	assert.False(t, isRPCError(&rpc.ClientError{ErrorString: "unexpected actNotFound"}, "actNotFound"))
+	assert.False(t, isRPCError(nil, "actNotFound"))
+}
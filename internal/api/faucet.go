@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Peersyst/xrpl-go/xrpl/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/server"
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+const (
+	// mainnetNetworkID is the network ID of the XRPL mainnet.
+	mainnetNetworkID = 0
+	// fundedWalletSeedBytes is the size of the random seed of a funded wallet, the BIP-39 seed size.
+	fundedWalletSeedBytes = 64
+)
+
+var (
+	// ErrNoFaucet is returned when funding a wallet without a configured faucet.
+	ErrNoFaucet = errors.New("no faucet configured")
+	// ErrFaucetOnMainnet is returned when funding a wallet while connected to the mainnet.
+	ErrFaucetOnMainnet = errors.New("faucet cannot be used on mainnet")
+)
+
+// httpFaucet funds accounts through the HTTP API of the public XRPL test network faucets,
+// which fund the destination of a POST request.
+type httpFaucet struct {
+	url    string
+	client rpc.HTTPClient
+}
+
+var _ common.FaucetProvider = (*httpFaucet)(nil)
+
+// NewHTTPFaucet creates a faucet provider posting to a faucet URL such as
+// "https://faucet.altnet.rippletest.net/accounts".
+//
+// Parameters:
+// - url: The faucet accounts endpoint
+// - client: The HTTP client sending the requests
+//
+// Returns the faucet provider.
+func NewHTTPFaucet(url string, client rpc.HTTPClient) common.FaucetProvider {
+	return &httpFaucet{url: url, client: client}
+}
+
+// FundWallet asks the faucet to fund the address.
+func (f *httpFaucet) FundWallet(address types.Address) error {
+	body, err := json.Marshal(map[string]string{"destination": address.String()})
+	if err != nil {
+		return fmt.Errorf("failed to encode faucet request: %w", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, f.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create faucet request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send faucet request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("faucet returned %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+// NewFundedWallet derives a wallet from a fresh random seed and funds it from the configured
+// faucet, for test network automation that needs new accounts. The faucet is refused while
+// connected to the mainnet.
+//
+// Parameters:
+// - path: The BIP-44 derivation path (e.g., "m/44'/144'/0'/0/0")
+//
+// Returns the funded wallet, an error wrapping ErrNoFaucet or ErrFaucetOnMainnet,
+// or an error if derivation or funding fails.
+func (b *Blockchain) NewFundedWallet(path string) (*wallet.Wallet, error) {
+	if b.c.FaucetProvider() == nil {
+		return nil, ErrNoFaucet
+	}
+
+	info, err := b.c.GetServerInfo(&server.InfoRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server info: %w", err)
+	}
+	if info.Info.NetworkID == mainnetNetworkID {
+		return nil, ErrFaucetOnMainnet
+	}
+
+	seed := make([]byte, fundedWalletSeedBytes)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate seed: %w", err)
+	}
+	w, err := crypto.NewWalletFromHexSeed(hex.EncodeToString(seed), path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.c.FundWallet(w); err != nil {
+		return nil, fmt.Errorf("failed to fund wallet: %w", err)
+	}
+	return w, nil
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+)
+
+// stubFaucet records the destinations the faucet is asked to fund.
+type stubFaucet struct {
+	mu           sync.Mutex
+	destinations []string
+}
+
+func (f *stubFaucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Destination string `json:"destination"`
+	}
+	if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	f.mu.Lock()
+	f.destinations = append(f.destinations, req.Destination)
+	f.mu.Unlock()
+	_, _ = w.Write([]byte(`{"account":{"address":"` + req.Destination + `"},"amount":100}`))
+}
+
+// networkServerInfo returns a server_info handler reporting the network ID.
+func networkServerInfo(networkID int) rpcHandler {
+	return func(params map[string]any) map[string]any {
+		return map[string]any{"info": map[string]any{"build_version": "2.4.0", "network_id": networkID}}
+	}
+}
+
+func newFaucetBlockchain(t *testing.T, networkID int, faucetURL string) *Blockchain {
+	t.Helper()
+	_, srv := newStubNode(t, map[string]rpcHandler{"server_info": networkServerInfo(networkID)})
+
+	cfg := config.NetworkConfig{URL: srv.URL, Timeout: 5}
+	cfg.Faucet.URL = faucetURL
+	setTestSystemAccount(&cfg)
+	bc, err := NewBlockchain(cfg)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	return bc
+}
+
+func TestBlockchain_NewFundedWallet(t *testing.T) {
+	faucet := &stubFaucet{}
+	faucetSrv := httptest.NewServer(faucet)
+	t.Cleanup(faucetSrv.Close)
+
+	bc := newFaucetBlockchain(t, 1, faucetSrv.URL+"/accounts")
+	first, err := bc.NewFundedWallet("m/44'/144'/0'/0/0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	second, err := bc.NewFundedWallet("m/44'/144'/0'/0/0")
+	assert.NoError(t, err)
+
+	// Every wallet comes from a fresh seed
+	assert.NotEqual(t, first.ClassicAddress, second.ClassicAddress)
+	assert.Equal(t, []string{first.ClassicAddress.String(), second.ClassicAddress.String()}, faucet.destinations)
+}
+
+func TestBlockchain_NewFundedWallet_Refused(t *testing.T) {
+	faucet := &stubFaucet{}
+	faucetSrv := httptest.NewServer(faucet)
+	t.Cleanup(faucetSrv.Close)
+
+	_, err := newFaucetBlockchain(t, 0, faucetSrv.URL).NewFundedWallet("m/44'/144'/0'/0/0")
+	assert.True(t, errors.Is(err, ErrFaucetOnMainnet), "got %v", err)
+	assert.Empty(t, faucet.destinations)
+
+	_, err = newFaucetBlockchain(t, 1, "").NewFundedWallet("m/44'/144'/0'/0/0")
+	assert.True(t, errors.Is(err, ErrNoFaucet), "got %v", err)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	}))
+	t.Cleanup(failing.Close)
+	_, err = newFaucetBlockchain(t, 1, failing.URL).NewFundedWallet("m/44'/144'/0'/0/0")
+	assert.ErrorContains(t, err, "rate limited")
+}
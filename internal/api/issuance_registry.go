@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// IssuanceRegistry maps warrant document hashes to the MPT issuances created for them,
+// so that a document is never emitted twice.
+type IssuanceRegistry interface {
+	// Lookup returns the issuance ID registered for the document hash, if any.
+	Lookup(ctx context.Context, documentHash string) (issuanceID string, found bool, err error)
+	// Register records the issuance created for the document hash.
+	Register(ctx context.Context, documentHash, issuanceID string) error
+}
+
+// MemoryIssuanceRegistry is an IssuanceRegistry kept in process memory.
+// It is safe for concurrent use; its mappings are lost on restart.
+type MemoryIssuanceRegistry struct {
+	mu        sync.RWMutex
+	issuances map[string]string
+}
+
+// NewMemoryIssuanceRegistry creates an empty in-memory issuance registry.
+func NewMemoryIssuanceRegistry() *MemoryIssuanceRegistry {
+	return &MemoryIssuanceRegistry{issuances: make(map[string]string)}
+}
+
+// Lookup returns the issuance ID registered for the document hash, if any.
+func (r *MemoryIssuanceRegistry) Lookup(_ context.Context, documentHash string) (string, bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	issuanceID, ok := r.issuances[documentHash]
+	return issuanceID, ok, nil
+}
+
+// Register records the issuance created for the document hash.
+func (r *MemoryIssuanceRegistry) Register(_ context.Context, documentHash, issuanceID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.issuances[documentHash] = issuanceID
+	return nil
+}
+
+// SetIssuanceRegistry injects the registry Emission checks document hashes against.
+//
+// Parameters:
+// - registry: The registry of emitted documents, nil disables the duplicate check
+func (t *Token) SetIssuanceRegistry(registry IssuanceRegistry) {
+	t.issuances = registry
+}
+
+// documentKey normalizes a document hash, which Validate accepts in either case and
+// optionally 0x-prefixed, so that one document always has the same registry key.
+func documentKey(documentHash string) string {
+	return strings.ToLower(strings.TrimPrefix(documentHash, "0x"))
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ledger "github.com/Peersyst/xrpl-go/xrpl/ledger-entry-types"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/version"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+var (
+	// ErrEntryNotFound is returned when the node reports the requested ledger object does not exist.
+	ErrEntryNotFound = errors.New("ledger entry not found")
+)
+
+// RippleStateLocator identifies a trustline by its two accounts and currency.
+type RippleStateLocator struct {
+	Accounts [2]string `json:"accounts"`
+	Currency string    `json:"currency"`
+}
+
+// MPTokenLocator identifies the MPToken object of a holder by the issuance and the holder account.
+type MPTokenLocator struct {
+	MPTIssuanceID string `json:"mpt_issuance_id"`
+	Account       string `json:"account"`
+}
+
+// LedgerEntryRequest is the ledger_entry request. Exactly one of the locators must be set.
+type LedgerEntryRequest struct {
+	common.BaseRequest
+	Index       string                 `json:"index,omitempty"`
+	MPTIssuance string                 `json:"mpt_issuance,omitempty"`
+	MPToken     *MPTokenLocator        `json:"mptoken,omitempty"`
+	RippleState *RippleStateLocator    `json:"ripple_state,omitempty"`
+	LedgerIndex common.LedgerSpecifier `json:"ledger_index,omitempty"`
+}
+
+func (*LedgerEntryRequest) Method() string {
+	return "ledger_entry"
+}
+
+func (*LedgerEntryRequest) APIVersion() int {
+	return version.RippledAPIV2
+}
+
+func (r *LedgerEntryRequest) Validate() error {
+	if r.Index == "" && r.MPTIssuance == "" && r.MPToken == nil && r.RippleState == nil {
+		return fmt.Errorf("ledger entry locator is required")
+	}
+	return nil
+}
+
+// LedgerEntryResponse is the ledger_entry response with the object in JSON form.
+type LedgerEntryResponse struct {
+	Index       string             `json:"index"`
+	LedgerIndex common.LedgerIndex `json:"ledger_index,omitempty"`
+	Node        map[string]any     `json:"node"`
+	Validated   bool               `json:"validated"`
+}
+
+// MPTokenIssuanceEntry is the MPTokenIssuance ledger object.
+type MPTokenIssuanceEntry struct {
+	Index             string        `json:"index,omitempty"`
+	LedgerEntryType   string        `json:"LedgerEntryType"`
+	Flags             uint32        `json:"Flags"`
+	Issuer            types.Address `json:"Issuer"`
+	Sequence          uint32        `json:"Sequence"`
+	AssetScale        uint8         `json:"AssetScale,omitempty"`
+	TransferFee       uint16        `json:"TransferFee,omitempty"`
+	MaximumAmount     string        `json:"MaximumAmount,omitempty"`
+	OutstandingAmount string        `json:"OutstandingAmount"`
+	MPTokenMetadata   string        `json:"MPTokenMetadata,omitempty"`
+}
+
+// MPTokenEntry is the MPToken ledger object holding an account's balance of an issuance.
+type MPTokenEntry struct {
+	Index             string        `json:"index,omitempty"`
+	LedgerEntryType   string        `json:"LedgerEntryType"`
+	Account           types.Address `json:"Account"`
+	MPTokenIssuanceID string        `json:"MPTokenIssuanceID"`
+	MPTAmount         string        `json:"MPTAmount,omitempty"`
+	Flags             uint32        `json:"Flags"`
+}
+
+// getLedgerEntry issues a ledger_entry request against the validated ledger,
+// unless the request selects another ledger.
+func (b *Blockchain) getLedgerEntry(req *LedgerEntryRequest) (map[string]any, error) {
+	if req.LedgerIndex == nil {
+		req.LedgerIndex = common.Validated
+	}
+	res, err := b.c.Request(req)
+	if err != nil {
+		if isRPCError(err, "entryNotFound") {
+			return nil, ErrEntryNotFound
+		}
+		return nil, fmt.Errorf("failed to get ledger entry: %w", err)
+	}
+
+	var resp LedgerEntryResponse
+	if err := res.GetResult(&resp); err != nil {
+		return nil, fmt.Errorf("failed to parse ledger entry response: %w", err)
+	}
+	if resp.Node == nil {
+		return nil, ErrEntryNotFound
+	}
+
+	return resp.Node, nil
+}
+
+// decodeLedgerEntry converts a ledger object in JSON form into a typed structure.
+func decodeLedgerEntry(node map[string]any, v any) error {
+	jsonData, err := json.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("failed to marshal ledger entry: %w", err)
+	}
+	if err := json.Unmarshal(jsonData, v); err != nil {
+		return fmt.Errorf("failed to unmarshal ledger entry: %w", err)
+	}
+	return nil
+}
+
+// GetLedgerEntry retrieves a single ledger object by its index.
+// This returns the authoritative state of one entry without scanning account objects.
+//
+// Parameters:
+// - index: The ledger object ID (64 hex characters)
+//
+// Returns the ledger object in JSON form, ErrEntryNotFound if it does not exist, or an error if the request fails.
+func (b *Blockchain) GetLedgerEntry(index string) (map[string]any, error) {
+	return b.getLedgerEntry(&LedgerEntryRequest{Index: index})
+}
+
+// GetMPTokenIssuanceEntry retrieves the MPTokenIssuance ledger object for an issuance.
+//
+// Parameters:
+// - issuanceID: The MPT issuance ID
+//
+// Returns the issuance object, ErrEntryNotFound if it does not exist, or an error if the request fails.
+func (b *Blockchain) GetMPTokenIssuanceEntry(issuanceID string) (*MPTokenIssuanceEntry, error) {
+	node, err := b.getLedgerEntry(&LedgerEntryRequest{MPTIssuance: issuanceID})
+	if err != nil {
+		return nil, err
+	}
+
+	var entry MPTokenIssuanceEntry
+	if err := decodeLedgerEntry(node, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetMPTokenEntry retrieves the MPToken ledger object of a holder.
+// The object exists once the holder has authorized the issuance.
+//
+// Parameters:
+// - account: The holder account address
+// - issuanceID: The MPT issuance ID
+//
+// Returns the MPToken object, ErrEntryNotFound if it does not exist, or an error if the request fails.
+func (b *Blockchain) GetMPTokenEntry(account, issuanceID string) (*MPTokenEntry, error) {
+	node, err := b.getLedgerEntry(&LedgerEntryRequest{
+		MPToken: &MPTokenLocator{
+			MPTIssuanceID: issuanceID,
+			Account:       account,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entry MPTokenEntry
+	if err := decodeLedgerEntry(node, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetRippleStateEntry retrieves the RippleState (trustline) ledger object between two accounts.
+//
+// Parameters:
+// - account: One side of the trustline
+// - issuer: The other side of the trustline
+// - currency: The currency code (3-char or 40-char hex)
+//
+// Returns the trustline object, ErrEntryNotFound if it does not exist, or an error if the request fails.
+func (b *Blockchain) GetRippleStateEntry(account, issuer, currency string) (*ledger.RippleState, error) {
+	node, err := b.getLedgerEntry(&LedgerEntryRequest{
+		RippleState: &RippleStateLocator{
+			Accounts: [2]string{account, issuer},
+			Currency: currency,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var entry ledger.RippleState
+	if err := decodeLedgerEntry(node, &entry); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
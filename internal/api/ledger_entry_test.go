@@ -0,0 +1,286 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mptIssuanceEntryFixture = `{
+		"index": "A0C3E7A1B2C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E",
+		"ledger_index": 8123456,
+		"node": {
+			"Flags": 104,
+			"Issuer": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC",
+			"LedgerEntryType": "MPTokenIssuance",
+			"MaximumAmount": "1",
+			"OutstandingAmount": "1",
+			"OwnerNode": "0",
+			"PreviousTxnID": "B5C2A2E1F1D64E7E5D9C3B3A2A1F0E0D0C0B0A09080706050403020100FFEEDD",
+			"PreviousTxnLgrSeq": 8123400,
+			"Sequence": 7,
+			"index": "A0C3E7A1B2C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E"
+		},
+		"validated": true
+	}`
+
+	rippleStateEntryFixture = `{
+		"index": "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B",
+		"ledger_index": 8123456,
+		"node": {
+			"Balance": {"currency": "524C555344000000000000000000000000000000", "issuer": "rrrrrrrrrrrrrrrrrrrrBZbvji", "value": "-250.5"},
+			"Flags": 131072,
+			"HighLimit": {"currency": "524C555344000000000000000000000000000000", "issuer": "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "value": "10000000"},
+			"HighNode": "0",
+			"LedgerEntryType": "RippleState",
+			"LowLimit": {"currency": "524C555344000000000000000000000000000000", "issuer": "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC", "value": "0"},
+			"LowNode": "0",
+			"PreviousTxnID": "B5C2A2E1F1D64E7E5D9C3B3A2A1F0E0D0C0B0A09080706050403020100FFEEDD",
+			"PreviousTxnLgrSeq": 8123400,
+			"index": "9CA88CDEDFF9252B3DE183CE35B038F57282BC9503CDFA1923EF9A95DF0D6F7B"
+		},
+		"validated": true
+	}`
+
+	entryNotFoundFixture = `{
+		"error": "entryNotFound",
+		"error_code": 21,
+		"error_message": "Entry not found.",
+		"ledger_index": 8123456,
+		"status": "error",
+		"validated": true
+	}`
+)
+
+func TestBlockchain_GetLedgerEntry(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": fixtureResult(mptIssuanceEntryFixture),
+	})
+
+	entry, err := bc.GetLedgerEntry("A0C3E7A1B2C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E")
+	assert.NoError(t, err)
+	assert.Equal(t, "MPTokenIssuance", entry["LedgerEntryType"])
+
+	params := node.Params("ledger_entry")
+	if assert.Len(t, params, 1) {
+		assert.Equal(t, "A0C3E7A1B2C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E", params[0]["index"])
+		assert.Equal(t, "validated", params[0]["ledger_index"])
+	}
+}
+
+func TestBlockchain_GetMPTokenIssuanceEntry(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": fixtureResult(mptIssuanceEntryFixture),
+	})
+
+	entry, err := bc.GetMPTokenIssuanceEntry("00000007CF5A0A1FA0A14E8D1D3F0FBE0C4D3E6F2D4B5C6A")
+	assert.NoError(t, err)
+	assert.Equal(t, "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC", entry.Issuer.String())
+	assert.Equal(t, uint32(7), entry.Sequence)
+	assert.Equal(t, "1", entry.OutstandingAmount)
+	assert.Equal(t, uint32(104), entry.Flags)
+
+	params := node.Params("ledger_entry")
+	if assert.Len(t, params, 1) {
+		assert.Equal(t, "00000007CF5A0A1FA0A14E8D1D3F0FBE0C4D3E6F2D4B5C6A", params[0]["mpt_issuance"])
+	}
+}
+
+func TestBlockchain_GetRippleStateEntry(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": fixtureResult(rippleStateEntryFixture),
+	})
+
+	entry, err := bc.GetRippleStateEntry("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC", RLUSDHex)
+	assert.NoError(t, err)
+	assert.Equal(t, "-250.5", entry.Balance.Value)
+	assert.Equal(t, "10000000", entry.HighLimit.Value)
+
+	params := node.Params("ledger_entry")
+	if assert.Len(t, params, 1) {
+		locator := params[0]["ripple_state"].(map[string]any)
+		assert.Equal(t, RLUSDHex, locator["currency"])
+		assert.Len(t, locator["accounts"], 2)
+	}
+}
+
+func TestBlockchain_GetLedgerEntry_NotFound(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": fixtureResult(entryNotFoundFixture),
+	})
+
+	_, err := bc.GetLedgerEntry("0000000000000000000000000000000000000000000000000000000000000000")
+	assert.True(t, errors.Is(err, ErrEntryNotFound))
+
+	_, err = bc.GetMPTokenIssuanceEntry("00000007CF5A0A1FA0A14E8D1D3F0FBE0C4D3E6F2D4B5C6A")
+	assert.True(t, errors.Is(err, ErrEntryNotFound))
+}
+
+func TestBlockchain_GetMPTokenBalance(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": func(params map[string]any) map[string]any {
+			return map[string]any{
+				"index": "B2C3E7A1B2C4D5E6F708192A3B4C5D6E7F8091A2B3C4D5E6F708192A3B4C5D6E",
+				"node": map[string]any{
+					"Account":           testAddress,
+					"Flags":             0,
+					"LedgerEntryType":   "MPToken",
+					"MPTAmount":         "5",
+					"MPTokenIssuanceID": "00000007A407AF5856CCF3C42619DAA925813FC955C72983",
+				},
+				"validated": true,
+			}
+		},
+	})
+
+	balance, err := bc.GetMPTokenBalance(testAddress, "00000007A407AF5856CCF3C42619DAA925813FC955C72983")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), balance)
+}
+
+func TestBlockchain_GetMPTokenBalance_NotAuthorized(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": fixtureResult(entryNotFoundFixture),
+	})
+
+	balance, err := bc.GetMPTokenBalance(testAddress, "00000007A407AF5856CCF3C42619DAA925813FC955C72983")
+	assert.NoError(t, err)
+	assert.Zero(t, balance)
+}
+
+func TestBlockchain_GetIssuanceOutstanding(t *testing.T) {
+	bc, node := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": fixtureResult(mptIssuanceEntryFixture),
+	})
+
+	issuanceID := "00000007A407AF5856CCF3C42619DAA925813FC955C72983"
+	outstanding, err := bc.GetIssuanceOutstanding(issuanceID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), outstanding)
+
+	params := node.Params("ledger_entry")
+	if assert.Len(t, params, 1) {
+		assert.Equal(t, issuanceID, params[0]["mpt_issuance"])
+		assert.Equal(t, "validated", params[0]["ledger_index"])
+	}
+}
+
+func TestBlockchain_GetIssuanceOutstanding_NotFound(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"ledger_entry": fixtureResult(entryNotFoundFixture),
+	})
+
+	_, err := bc.GetIssuanceOutstanding("00000007A407AF5856CCF3C42619DAA925813FC955C72983")
+	assert.True(t, errors.Is(err, ErrMPTIssuanceNotFound), "got %v", err)
+}
+
+func TestBlockchain_TransferMPToken_WithoutBalanceCheck(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+
+	_, err = bc.TransferMPToken(bc.w, tokenID, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", WithoutBalanceCheck())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, node.Calls("ledger_entry"))
+	assert.Equal(t, 1, node.Calls("submit"))
+}
+
+func TestBlockchain_TransferMPToken_WithValidation(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+
+	hash, err := bc.TransferMPToken(bc.w, tokenID, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", WithoutBalanceCheck(), WithValidation())
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, 1, node.Calls("submit"))
+	assert.Positive(t, node.Calls("tx"))
+}
+
+func TestBlockchain_TransferMPToken_WithAmount(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = func(params map[string]any) map[string]any {
+		result := fixtureResult(mptIssuanceEntryFixture)(params)
+		result["node"].(map[string]any)["MaximumAmount"] = "1000"
+		return result
+	}
+	bc, node := newTestBlockchain(t, handlers)
+	issuanceID := "00000007A407AF5856CCF3C42619DAA925813FC955C72983"
+
+	_, err := bc.TransferMPToken(bc.w, issuanceID, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		WithAmount("250"), WithoutBalanceCheck())
+	assert.NoError(t, err)
+	amount := submittedTx(t, node, 0)["Amount"].(map[string]any)
+	assert.Equal(t, "250", amount["value"])
+}
+
+func TestBlockchain_TransferMPToken_DecimalAmount(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	for _, value := range []string{"1.5", "0", "-1", "9223372036854775808"} {
+		_, err := bc.TransferMPToken(bc.w, "00000007A407AF5856CCF3C42619DAA925813FC955C72983",
+			"rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", WithAmount(value))
+		assert.True(t, errors.Is(err, ErrInvalidAmount), "%s: got %v", value, err)
+	}
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_TransferMPToken_OverMaximum(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = func(params map[string]any) map[string]any {
+		result := fixtureResult(mptIssuanceEntryFixture)(params)
+		result["node"].(map[string]any)["MaximumAmount"] = "1000"
+		return result
+	}
+	bc, node := newTestBlockchain(t, handlers)
+	issuanceID := "00000007A407AF5856CCF3C42619DAA925813FC955C72983"
+
+	_, err := bc.TransferMPToken(bc.w, issuanceID, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		WithAmount("1001"), WithoutBalanceCheck())
+	assert.True(t, errors.Is(err, ErrMPTAmountExceedsMaximum), "got %v", err)
+
+	// The issuer cannot send more than the unissued supply, 999 of the 1000
+	issuer := *bc.w
+	issuer.ClassicAddress = "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC"
+	_, err = bc.TransferMPToken(&issuer, issuanceID, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", WithAmount("1000"))
+	assert.True(t, errors.Is(err, ErrMPTAmountExceedsMaximum), "got %v", err)
+	assert.Contains(t, err.Error(), "1 outstanding")
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestBlockchain_MPTokenIssuanceDestroy_OutstandingSupply(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = fixtureResult(mptIssuanceEntryFixture)
+	bc, node := newTestBlockchain(t, handlers)
+
+	issuanceID := "00000007A407AF5856CCF3C42619DAA925813FC955C72983"
+	err := bc.MPTokenIssuanceDestroy(bc.w, issuanceID)
+	assert.True(t, errors.Is(err, ErrIssuanceHasHolders), "got %v", err)
+	assert.Contains(t, err.Error(), "1 outstanding")
+	assert.Equal(t, 0, node.Calls("submit"))
+
+	params := node.Params("ledger_entry")
+	if assert.Len(t, params, 1) {
+		assert.Equal(t, issuanceID, params[0]["mpt_issuance"])
+		assert.Equal(t, "current", params[0]["ledger_index"])
+	}
+}
+
+func TestBlockchain_MPTokenIssuanceDestroy_NoHolders(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = func(params map[string]any) map[string]any {
+		result := fixtureResult(mptIssuanceEntryFixture)(params)
+		result["node"].(map[string]any)["OutstandingAmount"] = "0"
+		return result
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	err := bc.MPTokenIssuanceDestroy(bc.w, "00000007A407AF5856CCF3C42619DAA925813FC955C72983")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, node.Calls("submit"))
+	assert.Equal(t, "MPTokenIssuanceDestroy", submittedTx(t, node, 0)["TransactionType"])
+}
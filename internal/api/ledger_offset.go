@@ -0,0 +1,55 @@
+package api
+
+import (
+	"fmt"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// SetLedgerOffset sets how many ledgers past the latest validated ledger a submitted
+// transaction stays valid, i.e. the distance to its LastLedgerSequence.
+// Transactions expected to queue need a larger offset than the client default of 20
+// ledgers, otherwise they expire with tefMAX_LEDGER.
+//
+// Parameters:
+// - offset: The ledger offset, zero keeps the client default
+func (b *Blockchain) SetLedgerOffset(offset uint32) {
+	b.ledgerOffset = offset
+}
+
+// setLastLedgerSequence sets LastLedgerSequence from the configured ledger offset.
+// The client's autofill only fills it when missing, with a fixed offset, so a transaction
+// carrying an explicit LastLedgerSequence or submitted without a configured offset is left as is.
+func (b *Blockchain) setLastLedgerSequence(tx transactions.FlatTransaction) error {
+	if _, ok := tx["LastLedgerSequence"]; ok || b.ledgerOffset == 0 {
+		return nil
+	}
+
+	index, err := b.c.GetLedgerIndex()
+	if err != nil {
+		return fmt.Errorf("failed to get ledger index: %w", err)
+	}
+	tx["LastLedgerSequence"] = index.Uint32() + b.ledgerOffset
+	return nil
+}
+
+// IsTxExpired reports whether a transaction can no longer be included in a ledger because
+// the latest validated ledger is past its LastLedgerSequence. A transaction whose submission
+// timed out may still land until then, so it must not be submitted again before it expires.
+// A transaction without LastLedgerSequence never expires.
+//
+// Parameters:
+// - lastLedgerSequence: The LastLedgerSequence of the transaction, zero if it has none
+//
+// Returns whether the transaction expired, or an error if the ledger index cannot be read.
+func (b *Blockchain) IsTxExpired(lastLedgerSequence uint32) (bool, error) {
+	if lastLedgerSequence == 0 {
+		return false, nil
+	}
+
+	index, err := b.c.GetLedgerIndex()
+	if err != nil {
+		return false, fmt.Errorf("failed to get ledger index: %w", err)
+	}
+	return index.Uint32() > lastLedgerSequence, nil
+}
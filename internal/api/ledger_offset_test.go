@@ -0,0 +1,55 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_SetLedgerOffset(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// The stub's validated ledger is 100
+	bc.SetLedgerOffset(500)
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(600), submittedTx(t, node, 0)["LastLedgerSequence"])
+
+	bc.SetLedgerOffset(100)
+	_, err = bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(200), submittedTx(t, node, 1)["LastLedgerSequence"])
+
+	// An explicit LastLedgerSequence is kept
+	_, err = bc.SubmitTx(bc.w, &transaction.AccountSet{
+		BaseTx: transaction.BaseTx{LastLedgerSequence: 150},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(150), submittedTx(t, node, 2)["LastLedgerSequence"])
+}
+
+func TestBlockchain_SetLedgerOffset_Default(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(120), submittedTx(t, node, 0)["LastLedgerSequence"])
+}
+
+func TestBlockchain_IsTxExpired(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// The stub's validated ledger is 100, a transaction may still land in its LastLedgerSequence
+	for lastLedgerSequence, want := range map[uint32]bool{99: true, 100: false, 120: false} {
+		expired, err := bc.IsTxExpired(lastLedgerSequence)
+		assert.NoError(t, err)
+		assert.Equal(t, want, expired, "LastLedgerSequence %d", lastLedgerSequence)
+	}
+
+	calls := node.TotalCalls()
+	expired, err := bc.IsTxExpired(0)
+	assert.NoError(t, err)
+	assert.False(t, expired)
+	assert.Equal(t, calls, node.TotalCalls())
+}
@@ -0,0 +1,142 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// LoanTerm is how long a loan is expected to run, over which its interest is projected.
+	LoanTerm = 100 * 24 * time.Hour
+
+	// loanTrustlines is the number of RLUSD trust lines a loan opens: the borrower's and the creditor's.
+	loanTrustlines = 2
+	// loanReserveObjects is the number of other reserve-holding objects a loan creates:
+	// the debt token issuance and the creditor's holdings of the debt and warrant tokens.
+	loanReserveObjects = 3
+)
+
+var (
+	// ErrInsufficientSystemBalance is returned when the system account cannot cover the XRP a loan needs.
+	ErrInsufficientSystemBalance = errors.New("insufficient system account balance")
+	// ErrInsufficientSystemFunds is returned when the system account cannot cover the XRP a loan needs
+	// along with the RLUSD it pays out.
+	ErrInsufficientSystemFunds = errors.New("insufficient system account funds")
+)
+
+// LifecycleCost is what a loan needs from its setup until it is bought out.
+type LifecycleCost struct {
+	// XRPReserve is the owner reserve of the debt token issuance and token holdings, in drops.
+	XRPReserve uint64
+	// TrustlineReserve is the owner reserve of the borrower's and creditor's trust lines, in drops.
+	TrustlineReserve uint64
+	// Interest is the RLUSD interest paid over the loan term.
+	Interest decimal.Decimal
+	// RLUSD is the principal plus the projected interest.
+	RLUSD decimal.Decimal
+}
+
+// XRP returns the total XRP the loan ties up, in drops.
+func (c LifecycleCost) XRP() uint64 {
+	return c.XRPReserve + c.TrustlineReserve
+}
+
+// TotalInterest returns the interest paid over the whole loan term: the sum of the
+// payments of its schedule, one per period and a shorter last one when the term is not
+// a whole number of periods. Interest is simple, not compounded: paid interest is not
+// added to the principal, so every period accrues on the principal alone and the
+// payments add up to the interest of the whole term.
+//
+// Returns the total interest, or zero if the loan has no term.
+func (l Loan) TotalInterest() decimal.Decimal {
+	if l.Term <= 0 {
+		return decimal.Zero
+	}
+	return l.interestOver(l.Term)
+}
+
+// EstimateLifecycleCost estimates the XRP reserves and RLUSD a loan needs over its term,
+// using the reserve requirements of the latest validated ledger.
+//
+// Parameters:
+// - loan: The loan to estimate, with its principal, rate and term set
+//
+// Returns the estimated cost, or an error if the loan has no term or the reserves cannot be read.
+func (l *Loans) EstimateLifecycleCost(loan Loan) (LifecycleCost, error) {
+	if loan.Term <= 0 {
+		return LifecycleCost{}, fmt.Errorf("loan term must be positive, got %s", loan.Term)
+	}
+
+	info, err := l.bc.GetBaseFeeAndReserve()
+	if err != nil {
+		return LifecycleCost{}, err
+	}
+	reserveInc := decimal.NewFromFloat32(info.ReserveIncXRP).Mul(decimal.NewFromInt(xrpToDrops))
+
+	interest := loan.TotalInterest()
+	return LifecycleCost{
+		XRPReserve:       uint64(reserveInc.Mul(decimal.NewFromInt(loanReserveObjects)).IntPart()),
+		TrustlineReserve: uint64(reserveInc.Mul(decimal.NewFromInt(loanTrustlines)).IntPart()),
+		Interest:         interest,
+		RLUSD:            loan.Principal.Add(interest),
+	}, nil
+}
+
+// CheckSystemBalance checks the system account holds the given drops above its own reserve.
+//
+// Parameters:
+// - drops: The XRP amount the system account must be able to spend, in drops
+//
+// Returns an error wrapping ErrInsufficientSystemBalance if it cannot, or if the balance cannot be read.
+func (b *Blockchain) CheckSystemBalance(drops uint64) error {
+	spendable, err := b.systemSpendableXRP()
+	if err != nil {
+		return err
+	}
+	if spendable.LessThan(decimal.NewFromUint64(drops)) {
+		return fmt.Errorf("%w: %s drops spendable, %d needed", ErrInsufficientSystemBalance, spendable, drops)
+	}
+	return nil
+}
+
+// AssertSystemBalance checks the system account can fund both sides of a loan: the XRP it
+// spends on reserves, above its own reserve, and the RLUSD it pays out. The system account
+// is the RLUSD issuer, so paying RLUSD issues it and the RLUSD requirement is always met;
+// only the XRP can fall short.
+//
+// Parameters:
+// - requiredXRP: The XRP the system account must be able to spend, in drops
+// - requiredRLUSD: The RLUSD the system account must be able to pay
+//
+// Returns an error wrapping ErrInsufficientSystemFunds with the XRP shortfall if it is not
+// covered, or an error if the balance cannot be read.
+func (b *Blockchain) AssertSystemBalance(requiredXRP uint64, requiredRLUSD decimal.Decimal) error {
+	spendable, err := b.systemSpendableXRP()
+	if err != nil {
+		return err
+	}
+	if shortfall := decimal.NewFromUint64(requiredXRP).Sub(spendable); shortfall.IsPositive() {
+		return fmt.Errorf("%w: short by %s drops to pay out %s RLUSD", ErrInsufficientSystemFunds, shortfall, requiredRLUSD)
+	}
+	return nil
+}
+
+// systemSpendableXRP returns the drops the system account holds above its base and owner reserve.
+func (b *Blockchain) systemSpendableXRP() (decimal.Decimal, error) {
+	info, err := b.GetAccountInfo(b.SystemWallet().ClassicAddress.String())
+	if err != nil {
+		return decimal.Zero, err
+	}
+	srvInfo, err := b.GetBaseFeeAndReserve()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	reserve := decimal.NewFromFloat32(srvInfo.ReserveBaseXRP).
+		Add(decimal.NewFromFloat32(srvInfo.ReserveIncXRP).Mul(decimal.NewFromInt(int64(info.AccountData.OwnerCount)))).
+		Mul(decimal.NewFromInt(xrpToDrops))
+	return decimal.NewFromUint64(uint64(info.AccountData.Balance)).Sub(reserve), nil
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoans_EstimateLifecycleCost(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+
+	// 1,000,000 at 36.5% a year accrues 1,000 a day over the 100 day term
+	loan := newTestLoan(t)
+	cost, err := loans.EstimateLifecycleCost(loan)
+	assert.NoError(t, err)
+
+	// The stub owner reserve is 0.2 XRP per object
+	assert.Equal(t, uint64(600_000), cost.XRPReserve)
+	assert.Equal(t, uint64(400_000), cost.TrustlineReserve)
+	assert.Equal(t, "100000", cost.Interest.String())
+	assert.Equal(t, "1100000", cost.RLUSD.String())
+	assert.Equal(t, uint64(1_000_000), cost.XRP())
+
+	loan.Term = 0
+	_, err = loans.EstimateLifecycleCost(loan)
+	assert.Error(t, err)
+}
+
+func TestLoan_TotalInterest(t *testing.T) {
+	loan := Loan{
+		Principal:          decimal.NewFromInt(1000),
+		AnnualInterestRate: decimal.NewFromInt(10),
+		Period:             30 * 24 * time.Hour,
+		Term:               100 * 24 * time.Hour,
+	}
+
+	// 100 a year is 8.219178... every 30 days, three times, then 2.739726... for the last 10 days
+	assert.Equal(t, "27.3972602739726027", loan.TotalInterest().String())
+
+	// A term of whole periods has no shorter last payment
+	loan.Term = 90 * 24 * time.Hour
+	assert.Equal(t, "24.6575342465753425", loan.TotalInterest().String())
+
+	// Without a period the whole term is paid at once
+	loan.Period = 0
+	assert.Equal(t, "24.6575342465753425", loan.TotalInterest().String())
+
+	loan.Term = 0
+	assert.True(t, loan.TotalInterest().IsZero())
+}
+
+func TestBlockchain_CheckSystemBalance(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// The stub system account holds 1,000 XRP with no owned objects, 1 XRP is reserved
+	assert.NoError(t, bc.CheckSystemBalance(999_000_000))
+
+	err := bc.CheckSystemBalance(999_000_001)
+	assert.True(t, errors.Is(err, ErrInsufficientSystemBalance), "got %v", err)
+}
+
+func TestBlockchain_AssertSystemBalance(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// The stub system account holds 1,000 XRP with no owned objects, 1 XRP is reserved.
+	// It issues RLUSD itself, so any RLUSD requirement is covered.
+	assert.NoError(t, bc.AssertSystemBalance(999_000_000, decimal.NewFromInt(1_100_000)))
+	assert.Equal(t, 0, node.Calls("account_lines"))
+
+	err := bc.AssertSystemBalance(1_000_000_000, decimal.NewFromInt(1_100_000))
+	assert.True(t, errors.Is(err, ErrInsufficientSystemFunds), "got %v", err)
+	assert.Contains(t, err.Error(), "short by 1000000 drops to pay out 1100000 RLUSD")
+}
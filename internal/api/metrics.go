@@ -0,0 +1,76 @@
+package api
+
+import (
+	"errors"
+	"time"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// ResultUnknown is observed for submissions that failed without an engine result from the node,
+// such as transport failures or transactions that were not validated in time.
+const ResultUnknown transactions.TxResult = "unknown"
+
+// Metrics observes transaction submissions.
+//
+// A Prometheus implementation typically records durationSeconds in a histogram and
+// counts submissions and fee drops in counters, all labelled by transaction type and result:
+//
+//	type promMetrics struct {
+//		duration *prometheus.HistogramVec // labels: tx_type, result
+//		fees     *prometheus.CounterVec   // labels: tx_type
+//	}
+//
+//	func (m promMetrics) ObserveSubmit(txType string, durationSeconds float64, result transactions.TxResult, feeDrops uint64) {
+//		m.duration.WithLabelValues(txType, string(result)).Observe(durationSeconds)
+//		m.fees.WithLabelValues(txType).Add(float64(feeDrops))
+//	}
+//
+// and is injected with SetMetrics.
+type Metrics interface {
+	// ObserveSubmit is called once per submission, whether it succeeded or failed.
+	// The fee is zero when the transaction was never signed.
+	ObserveSubmit(txType string, durationSeconds float64, result transactions.TxResult, feeDrops uint64)
+}
+
+// NopMetrics is a Metrics discarding every observation.
+type NopMetrics struct{}
+
+// ObserveSubmit does nothing.
+func (NopMetrics) ObserveSubmit(string, float64, transactions.TxResult, uint64) {}
+
+// SetMetrics injects the observer of transaction submissions.
+//
+// Parameters:
+// - metrics: The observer, nil disables observation
+func (b *Blockchain) SetMetrics(metrics Metrics) {
+	if metrics == nil {
+		metrics = NopMetrics{}
+	}
+	b.metrics = metrics
+}
+
+// observeSubmit reports a finished submission to the metrics observer. The result is
+// tesSUCCESS without an error, the engine result of an EngineError, or ResultUnknown.
+func (b *Blockchain) observeSubmit(txType transactions.TxType, start time.Time, feeDrops uint64, err error) {
+	if b.metrics == nil {
+		return
+	}
+
+	result := transactions.TesSUCCESS
+	var engineErr *EngineError
+	switch {
+	case err == nil:
+	case errors.As(err, &engineErr):
+		result = engineErr.Result
+	default:
+		result = ResultUnknown
+	}
+	b.metrics.ObserveSubmit(string(txType), time.Since(start).Seconds(), result, feeDrops)
+}
+
+// feeDrops reads the Fee field of a signed transaction, zero if it is absent.
+func feeDrops(tx transactions.FlatTransaction) uint64 {
+	drops, _ := FeeFromAny(tx["Fee"])
+	return drops
+}
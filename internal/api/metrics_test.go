@@ -0,0 +1,107 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+// submitObservation is a single call of ObserveSubmit.
+type submitObservation struct {
+	txType   string
+	duration float64
+	result   transaction.TxResult
+	fee      uint64
+}
+
+// recordingMetrics keeps every submit observation in memory.
+type recordingMetrics struct {
+	observations []submitObservation
+}
+
+func (m *recordingMetrics) ObserveSubmit(txType string, durationSeconds float64, result transaction.TxResult, feeDrops uint64) {
+	m.observations = append(m.observations, submitObservation{txType, durationSeconds, result, feeDrops})
+}
+
+func TestBlockchain_SubmitTx_Metrics(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	metrics := &recordingMetrics{}
+	bc.SetMetrics(metrics)
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	_, _, err = bc.SubmitTxWithSequence(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+
+	if assert.Len(t, metrics.observations, 2) {
+		for _, o := range metrics.observations {
+			assert.Equal(t, "AccountSet", o.txType)
+			assert.Equal(t, transaction.TesSUCCESS, o.result)
+			assert.Equal(t, uint64(stubBaseFee), o.fee)
+			assert.Positive(t, o.duration)
+		}
+	}
+}
+
+func TestBlockchain_SubmitTx_MetricsFailure(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tecNO_DST"))
+	metrics := &recordingMetrics{}
+	bc.SetMetrics(metrics)
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.Error(t, err)
+	_, _, err = bc.SubmitTxWithSequence(bc.w, &transaction.AccountSet{})
+	assert.Error(t, err)
+
+	if assert.Len(t, metrics.observations, 2) {
+		for _, o := range metrics.observations {
+			assert.Equal(t, transaction.TxResult("tecNO_DST"), o.result)
+			assert.Equal(t, uint64(stubBaseFee), o.fee)
+		}
+	}
+}
+
+func TestBlockchain_SubmitTx_MetricsTransportFailure(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	delete(handlers, "submit")
+	bc, _ := newTestBlockchain(t, handlers)
+	metrics := &recordingMetrics{}
+	bc.SetMetrics(metrics)
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.Error(t, err)
+
+	if assert.Len(t, metrics.observations, 1) {
+		assert.Equal(t, ResultUnknown, metrics.observations[0].result)
+		assert.Zero(t, metrics.observations[0].fee)
+	}
+}
+
+func TestBlockchain_SetMetrics_Nil(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetMetrics(nil)
+
+	assert.Equal(t, NopMetrics{}, bc.metrics)
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+}
+
+func TestBlockchain_SubmitBlob_Metrics(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tecUNFUNDED_PAYMENT"))
+	metrics := &recordingMetrics{}
+	bc.SetMetrics(metrics)
+
+	blob, _, err := bc.w.Sign(testPaymentFlatTx())
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	_, err = bc.SubmitBlob(blob, false)
+	assert.Error(t, err)
+
+	if assert.Len(t, metrics.observations, 1) {
+		assert.Equal(t, "Payment", metrics.observations[0].txType)
+		assert.Equal(t, transaction.TxResult("tecUNFUNDED_PAYMENT"), metrics.observations[0].result)
+		assert.Equal(t, uint64(12), metrics.observations[0].fee)
+	}
+}
@@ -1,12 +1,46 @@
 package api
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+)
+
+const (
+	// issuanceIDLength is the length of an MPT issuance ID in hex characters:
+	// a 4-byte sequence followed by a 20-byte issuer account ID.
+	issuanceIDLength = 48
+)
+
+// defaultWarrantMetadata holds the warrant metadata used where the configuration leaves a field empty.
+var defaultWarrantMetadata = config.MetadataDefaults{
+	Ticker:      "FSWRNT",
+	Name:        "FortStock Warrant",
+	Desc:        "Digital representation of real-world asset-backed warrants",
+	HomeURL:     "https://fortstock.io",
+	RulebookURL: "https://fortstock.io/rulebook/",
+}
+
+var (
+	// ErrInvalidIssuanceID is returned when an MPT issuance ID is malformed.
+	ErrInvalidIssuanceID = errors.New("invalid issuance ID")
+	// ErrInvalidDocumentHash is returned when a warrant document hash is empty or not hex.
+	ErrInvalidDocumentHash = errors.New("invalid document hash")
+	// ErrInvalidIssuer is returned when a token issuer is not a valid classic address.
+	ErrInvalidIssuer = errors.New("invalid issuer address")
+	// ErrInvalidCreditor is returned when the lender of a debt token is not a valid classic address.
+	ErrInvalidCreditor = errors.New("invalid creditor address")
+	// ErrInvalidMetadataURI is returned when an external metadata URI is not an https or ipfs URL.
+	ErrInvalidMetadataURI = errors.New("invalid metadata URI")
 )
 
 // MPToken represents a Multi-Purpose Token with associated metadata.
@@ -14,6 +48,12 @@ import (
 type WarrantMPToken struct {
 	DocumentHash string
 	Issuer       string
+	// MetadataURI points to an off-chain metadata document. When set, the issuance
+	// carries only a compact metadata referencing it, see CreateMetadata.
+	MetadataURI string
+	// Defaults holds the ticker, name, description and links of the metadata.
+	// Empty fields keep the built-in values.
+	Defaults config.MetadataDefaults
 }
 
 // NewMPToken creates and returns a new MPToken instance.
@@ -25,11 +65,81 @@ func NewWarrantMPToken(docHash, issuer string) WarrantMPToken {
 	}
 }
 
+// WithMetadataURI returns the token with its metadata stored off-chain at the URI.
+//
+// Parameters:
+// - uri: The https or ipfs URL of the metadata document
+func (m WarrantMPToken) WithMetadataURI(uri string) WarrantMPToken {
+	m.MetadataURI = uri
+	return m
+}
+
+// WithMetadataDefaults returns the token with its ticker, name, description and links taken
+// from the configured defaults.
+//
+// Parameters:
+// - defaults: The configured metadata defaults; empty fields keep the built-in values
+func (m WarrantMPToken) WithMetadataDefaults(defaults config.MetadataDefaults) WarrantMPToken {
+	m.Defaults = defaults
+	return m
+}
+
+// metadataDefaults returns the configured metadata defaults with empty fields filled
+// from defaultWarrantMetadata.
+func (m WarrantMPToken) metadataDefaults() config.MetadataDefaults {
+	d := m.Defaults
+	if d.Ticker == "" {
+		d.Ticker = defaultWarrantMetadata.Ticker
+	}
+	if d.Name == "" {
+		d.Name = defaultWarrantMetadata.Name
+	}
+	if d.Desc == "" {
+		d.Desc = defaultWarrantMetadata.Desc
+	}
+	if d.HomeURL == "" {
+		d.HomeURL = defaultWarrantMetadata.HomeURL
+	}
+	if d.RulebookURL == "" {
+		d.RulebookURL = defaultWarrantMetadata.RulebookURL
+	}
+	return d
+}
+
+// Validate checks the document hash is non-empty hex, optionally 0x-prefixed,
+// the issuer is a valid classic address, the metadata URI, if any, is an
+// https or ipfs URL and the metadata defaults are valid.
+//
+// Returns an error wrapping ErrInvalidDocumentHash, ErrInvalidIssuer, ErrInvalidMetadataURI
+// or config.ErrInvalidMetadataConfig if the token is malformed.
+func (m WarrantMPToken) Validate() error {
+	if m.DocumentHash == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidDocumentHash)
+	}
+	if _, err := hex.DecodeString(strings.TrimPrefix(m.DocumentHash, "0x")); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidDocumentHash, err)
+	}
+	if !addresscodec.IsValidClassicAddress(m.Issuer) {
+		return fmt.Errorf("%w: %q", ErrInvalidIssuer, m.Issuer)
+	}
+	if m.MetadataURI != "" {
+		u, err := url.Parse(m.MetadataURI)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "ipfs") || u.Host == "" {
+			return fmt.Errorf("%w: %q must be an https or ipfs URL", ErrInvalidMetadataURI, m.MetadataURI)
+		}
+	}
+	return m.Defaults.Validate()
+}
+
 // CreateMetadata generates the metadata structure required for MPT creation.
 // This includes token details, URLs, and additional information like document hash and signature.
+// With a MetadataURI, the metadata is the compact form instead: the ticker and asset class,
+// the URI as its only URL and the document hash, the rest being served off-chain.
+// The ticker, name, description and links come from the metadata defaults.
 //
 // Returns the metadata structure or an error if creation fails.
 func (m WarrantMPToken) CreateMetadata() (MPTokenMetadata, error) {
+	d := m.metadataDefaults()
 	addInfo, err := json.Marshal(map[string]string{
 		"document_hash": m.DocumentHash,
 	})
@@ -37,21 +147,37 @@ func (m WarrantMPToken) CreateMetadata() (MPTokenMetadata, error) {
 		return MPTokenMetadata{}, fmt.Errorf("failed to marshal additional info: %w", err)
 	}
 
+	if m.MetadataURI != "" {
+		return MPTokenMetadata{
+			Ticker:        d.Ticker,
+			AssetClass:    "rwa",
+			AssetSubclass: "commodity",
+			Urls: []MPTokenMetadataUrl{
+				{
+					Url:   m.MetadataURI,
+					Type:  "document",
+					Title: "Metadata",
+				},
+			},
+			AdditionalInfo: addInfo,
+		}, nil
+	}
+
 	return MPTokenMetadata{
-		Ticker:        "FSWRNT",
-		Name:          "FortStock Warrant",
-		Desc:          "Digital representation of real-world asset-backed warrants",
+		Ticker:        d.Ticker,
+		Name:          d.Name,
+		Desc:          d.Desc,
 		AssetClass:    "rwa",
 		AssetSubclass: "commodity",
 		IssuerName:    m.Issuer,
 		Urls: []MPTokenMetadataUrl{
 			{
-				Url:   "https://fortstock.io",
+				Url:   d.HomeURL,
 				Type:  "website",
 				Title: "Home",
 			},
 			{
-				Url:   "https://fortstock.io/rulebook/",
+				Url:   d.RulebookURL,
 				Type:  "document",
 				Title: "Legal framework",
 			},
@@ -82,6 +208,24 @@ func NewDebtMPToken(collateralTokenID string, ownerAddress string, creditorAddre
 	}
 }
 
+// Validate checks the collateral token ID and that the borrower, who issues the debt token,
+// and the lender are valid classic addresses.
+//
+// Returns an error wrapping ErrInvalidIssuanceID, ErrInvalidIssuer or ErrInvalidCreditor if
+// the token is malformed.
+func (d DebtMPToken) Validate() error {
+	if err := ValidateIssuanceID(d.CollateralTokenID); err != nil {
+		return err
+	}
+	if !addresscodec.IsValidClassicAddress(d.OwnerAddress) {
+		return fmt.Errorf("%w: %q", ErrInvalidIssuer, d.OwnerAddress)
+	}
+	if !addresscodec.IsValidClassicAddress(d.CreditorAddress) {
+		return fmt.Errorf("%w: %q", ErrInvalidCreditor, d.CreditorAddress)
+	}
+	return nil
+}
+
 func (d DebtMPToken) CreateMetadata() (MPTokenMetadata, error) {
 	addInfo, err := json.Marshal(map[string]string{
 		"currency":             d.Currency,
@@ -145,3 +289,55 @@ func CreateIssuanceID(issuer string, sequence uint32) (string, error) {
 	accountIDHex := fmt.Sprintf("%X", accountID)
 	return fmt.Sprintf("%08X%s", sequence, accountIDHex), nil
 }
+
+// DecodeIssuanceID splits an issuance ID into the issuer address and the sequence
+// of the MPTokenIssuanceCreate transaction, reversing CreateIssuanceID.
+//
+// Parameters:
+// - id: The MPT issuance ID to decode
+//
+// Returns the issuer address and the issuance sequence, or an error wrapping
+// ErrInvalidIssuanceID if the ID is malformed.
+func DecodeIssuanceID(id string) (issuer string, sequence uint32, err error) {
+	if err := ValidateIssuanceID(id); err != nil {
+		return "", 0, err
+	}
+
+	bytes, err := hex.DecodeString(id)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: %v", ErrInvalidIssuanceID, err)
+	}
+
+	issuer, err = addresscodec.EncodeAccountIDToClassicAddress(bytes[4:])
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to encode account id to classic address: %w", err)
+	}
+
+	return issuer, binary.BigEndian.Uint32(bytes[:4]), nil
+}
+
+// ValidateIssuanceID checks that the issuance ID is a 24-byte hex string
+// with a non-zero sequence.
+//
+// Parameters:
+// - id: The MPT issuance ID to validate
+//
+// Returns an error wrapping ErrInvalidIssuanceID if the ID is malformed.
+func ValidateIssuanceID(id string) error {
+	if id == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidIssuanceID)
+	}
+	if len(id) != issuanceIDLength {
+		return fmt.Errorf("%w: expected %d hex characters, got %d", ErrInvalidIssuanceID, issuanceIDLength, len(id))
+	}
+
+	bytes, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidIssuanceID, err)
+	}
+	if binary.BigEndian.Uint32(bytes[:4]) == 0 {
+		return fmt.Errorf("%w: zero sequence", ErrInvalidIssuanceID)
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+)
+
+var (
+	// ErrInsufficientMPTBalance is returned when a transfer sender holds less of the token than it sends.
+	ErrInsufficientMPTBalance = errors.New("insufficient MPT balance")
+	// ErrMPTokenAuthorization is returned when a holder fails to authorize an MPT issuance.
+	ErrMPTokenAuthorization = errors.New("failed to authorize MPT")
+	// ErrIssuanceHasHolders is returned when an MPT issuance with outstanding supply is destroyed.
+	ErrIssuanceHasHolders = errors.New("issuance has outstanding holders")
+	// ErrMPTIssuanceNotFound is returned when an MPT issuance does not exist in the ledger.
+	ErrMPTIssuanceNotFound = errors.New("MPT issuance not found")
+	// ErrMPTAmountExceedsMaximum is returned when an MPT transfer exceeds the maximum amount of the issuance.
+	ErrMPTAmountExceedsMaximum = errors.New("MPT amount exceeds issuance maximum")
+)
+
+// transferOptions holds the settings of a single MPT transfer.
+type transferOptions struct {
+	amount            string
+	skipBalanceCheck  bool
+	waitForValidation bool
+	submitOpts        []SubmitOption
+}
+
+// TransferOption configures TransferMPToken.
+type TransferOption func(*transferOptions)
+
+// WithoutBalanceCheck skips the sender balance pre-check, for callers that checked
+// the balances of several transfers collectively.
+func WithoutBalanceCheck() TransferOption {
+	return func(o *transferOptions) {
+		o.skipBalanceCheck = true
+	}
+}
+
+// WithAmount transfers the given number of units of the token instead of a single one.
+// The value is an integer in the token's smallest unit, as MPT amounts are written.
+//
+// Parameters:
+// - value: The amount to transfer, such as "250"
+func WithAmount(value string) TransferOption {
+	return func(o *transferOptions) {
+		o.amount = value
+	}
+}
+
+// WithValidation makes the transfer return only once it is validated in a ledger,
+// instead of as soon as the node accepts it.
+func WithValidation() TransferOption {
+	return func(o *transferOptions) {
+		o.waitForValidation = true
+	}
+}
+
+// WithSubmitOptions applies options of the submission, such as WithSourceTag, to the transfer.
+//
+// Parameters:
+// - opts: Options of the submission of the transfer
+func WithSubmitOptions(opts ...SubmitOption) TransferOption {
+	return func(o *transferOptions) {
+		o.submitOpts = append(o.submitOpts, opts...)
+	}
+}
+
+// GetMPTokenBalance retrieves the amount of an MPT held by an account.
+// An account that has not authorized the issuance holds none.
+//
+// Parameters:
+// - account: The holder account address
+// - issuanceID: The MPT issuance ID
+//
+// Returns the balance in the token's smallest unit, or an error if the request fails.
+func (b *Blockchain) GetMPTokenBalance(account, issuanceID string) (uint64, error) {
+	entry, err := b.GetMPTokenEntry(account, issuanceID)
+	if errors.Is(err, ErrEntryNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get mptoken: %w", err)
+	}
+	if entry.MPTAmount == "" {
+		return 0, nil
+	}
+
+	balance, err := strconv.ParseUint(entry.MPTAmount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse MPTAmount %q: %w", entry.MPTAmount, err)
+	}
+	return balance, nil
+}
+
+// GetIssuanceOutstanding retrieves how many units of an issuance are held by accounts
+// other than the issuer, as of the latest validated ledger.
+//
+// Parameters:
+// - issuanceID: The MPT issuance ID
+//
+// Returns the outstanding amount in the token's smallest unit, an error wrapping
+// ErrMPTIssuanceNotFound if the issuance does not exist, or an error if the request fails.
+func (b *Blockchain) GetIssuanceOutstanding(issuanceID string) (uint64, error) {
+	entry, err := b.GetMPTokenIssuanceEntry(issuanceID)
+	if errors.Is(err, ErrEntryNotFound) {
+		return 0, fmt.Errorf("%w: %s", ErrMPTIssuanceNotFound, issuanceID)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get issuance: %w", err)
+	}
+	if entry.OutstandingAmount == "" {
+		return 0, nil
+	}
+
+	outstanding, err := strconv.ParseUint(entry.OutstandingAmount, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse OutstandingAmount %q: %w", entry.OutstandingAmount, err)
+	}
+	return outstanding, nil
+}
+
+// parseMPTValue parses an MPT amount the way the binary codec accepts it: an integer with
+// no decimal point that fits in 63 bits. A transfer also needs it to be positive.
+func parseMPTValue(value string) (uint64, error) {
+	if strings.Contains(value, ".") {
+		return 0, fmt.Errorf("%w: MPT amount %q must be an integer", ErrInvalidAmount, value)
+	}
+	amount, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || amount > math.MaxInt64 {
+		return 0, fmt.Errorf("%w: MPT amount %q is out of range", ErrInvalidAmount, value)
+	}
+	if amount == 0 {
+		return 0, fmt.Errorf("%w: MPT amount must be positive", ErrInvalidAmount)
+	}
+	return amount, nil
+}
+
+// checkMPTMaximum checks a transfer of amount stays within the maximum amount of the issuance.
+// An issuer sends from the unissued supply, so its transfer must also fit on top of the
+// outstanding amount. An issuance the ledger does not know yet is left to the node.
+func (b *Blockchain) checkMPTMaximum(sender, issuanceID string, amount uint64) error {
+	entry, err := b.GetMPTokenIssuanceEntry(issuanceID)
+	if errors.Is(err, ErrEntryNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get issuance: %w", err)
+	}
+
+	maximum := uint64(math.MaxInt64)
+	if entry.MaximumAmount != "" {
+		if maximum, err = strconv.ParseUint(entry.MaximumAmount, 10, 64); err != nil {
+			return fmt.Errorf("failed to parse MaximumAmount %q: %w", entry.MaximumAmount, err)
+		}
+	}
+	if amount > maximum {
+		return fmt.Errorf("%w: %d of %s, maximum %d", ErrMPTAmountExceedsMaximum, amount, issuanceID, maximum)
+	}
+
+	if sender != entry.Issuer.String() || entry.OutstandingAmount == "" {
+		return nil
+	}
+	outstanding, err := strconv.ParseUint(entry.OutstandingAmount, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse OutstandingAmount %q: %w", entry.OutstandingAmount, err)
+	}
+	if outstanding > maximum || amount > maximum-outstanding {
+		return fmt.Errorf("%w: %d of %s on %d outstanding, maximum %d",
+			ErrMPTAmountExceedsMaximum, amount, issuanceID, outstanding, maximum)
+	}
+	return nil
+}
+
+// checkMPTokenBalance checks the sender holds at least amount of the token.
+// The issuer is not checked: it sends from the unissued supply, which the node bounds by MaximumAmount.
+func (b *Blockchain) checkMPTokenBalance(sender, issuanceID string, amount uint64) error {
+	issuer, _, err := DecodeIssuanceID(issuanceID)
+	if err != nil {
+		return err
+	}
+	if sender == issuer {
+		return nil
+	}
+
+	balance, err := b.GetMPTokenBalance(sender, issuanceID)
+	if err != nil {
+		return err
+	}
+	if balance < amount {
+		return fmt.Errorf("%w: %s holds %d of %s, needs %d", ErrInsufficientMPTBalance, sender, balance, issuanceID, amount)
+	}
+	return nil
+}
+
+// checkIssuanceUnheld checks no holder keeps any of the issuance. The current ledger is
+// queried so that returns submitted just before, but not yet validated, are counted.
+func (b *Blockchain) checkIssuanceUnheld(issuanceID string) error {
+	node, err := b.getLedgerEntry(&LedgerEntryRequest{MPTIssuance: issuanceID, LedgerIndex: common.Current})
+	if err != nil {
+		return fmt.Errorf("failed to get issuance: %w", err)
+	}
+	var entry MPTokenIssuanceEntry
+	if err := decodeLedgerEntry(node, &entry); err != nil {
+		return err
+	}
+
+	if entry.OutstandingAmount != "" && entry.OutstandingAmount != "0" {
+		return fmt.Errorf("%w: %s outstanding of %s", ErrIssuanceHasHolders, entry.OutstandingAmount, issuanceID)
+	}
+	return nil
+}
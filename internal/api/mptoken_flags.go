@@ -0,0 +1,67 @@
+package api
+
+import (
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// MPTFlags selects the capabilities of an MPT issuance.
+type MPTFlags struct {
+	// CanLock lets the issuer lock the balances of holders.
+	CanLock bool
+	// RequireAuth requires the issuer to authorize every holder.
+	RequireAuth bool
+	// CanEscrow lets holders place the token in escrow.
+	CanEscrow bool
+	// CanTrade lets holders trade the token on the DEX.
+	CanTrade bool
+	// CanTransfer lets holders transfer the token to accounts other than the issuer.
+	CanTransfer bool
+	// CanClawback lets the issuer claw the token back from holders.
+	CanClawback bool
+}
+
+// DefaultMPTFlags returns the flags of warrant and debt token issuances:
+// escrow, trade and transfer are allowed.
+func DefaultMPTFlags() MPTFlags {
+	return MPTFlags{CanEscrow: true, CanTrade: true, CanTransfer: true}
+}
+
+// applyTo sets the selected flags on the issuance transaction.
+func (f MPTFlags) applyTo(tx *transactions.MPTokenIssuanceCreate) {
+	if f.CanLock {
+		tx.SetMPTCanLockFlag()
+	}
+	if f.RequireAuth {
+		tx.SetMPTRequireAuthFlag()
+	}
+	if f.CanEscrow {
+		tx.SetMPTCanEscrowFlag()
+	}
+	if f.CanTrade {
+		tx.SetMPTCanTradeFlag()
+	}
+	if f.CanTransfer {
+		tx.SetMPTCanTransferFlag()
+	}
+	if f.CanClawback {
+		tx.SetMPTCanClawbackFlag()
+	}
+}
+
+// issuanceOptions holds the settings of a single MPT issuance.
+type issuanceOptions struct {
+	flags MPTFlags
+}
+
+// IssuanceOption configures MPTokenIssuanceCreate.
+type IssuanceOption func(*issuanceOptions)
+
+// WithMPTFlags sets the flags of the issuance instead of DefaultMPTFlags.
+//
+// Parameters:
+// - flags: The capabilities of the issuance
+func WithMPTFlags(flags MPTFlags) IssuanceOption {
+	return func(o *issuanceOptions) {
+		o.flags = flags
+	}
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+)
+
+func TestValidateIssuanceID(t *testing.T) {
+	valid, err := CreateIssuanceID(testAddress, 1)
+	if err != nil {
+		t.Fatalf("failed to create issuance id: %v", err)
+	}
+	assert.NoError(t, ValidateIssuanceID(valid))
+
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{name: "empty", id: ""},
+		{name: "too short", id: valid[:46]},
+		{name: "too long", id: valid + "00"},
+		{name: "non-hex", id: "ZZ" + valid[2:]},
+		{name: "zero sequence", id: strings.Repeat("0", 8) + valid[8:]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateIssuanceID(tt.id)
+			assert.True(t, errors.Is(err, ErrInvalidIssuanceID), "got %v", err)
+		})
+	}
+}
+
+func TestWarrantMPToken_Validate(t *testing.T) {
+	assert.NoError(t, NewWarrantMPToken("abcdef", testAddress).Validate())
+	assert.NoError(t, NewWarrantMPToken("0xABCDEF", testAddress).Validate())
+
+	tests := []struct {
+		name    string
+		token   WarrantMPToken
+		wantErr error
+	}{
+		{name: "empty hash", token: NewWarrantMPToken("", testAddress), wantErr: ErrInvalidDocumentHash},
+		{name: "non-hex hash", token: NewWarrantMPToken("not-a-hash", testAddress), wantErr: ErrInvalidDocumentHash},
+		{name: "invalid issuer", token: NewWarrantMPToken("abcdef", "rInvalid"), wantErr: ErrInvalidIssuer},
+		{name: "empty issuer", token: NewWarrantMPToken("abcdef", ""), wantErr: ErrInvalidIssuer},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.token.Validate()
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
+
+func TestDebtMPToken_Validate(t *testing.T) {
+	const creditor = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	collateral, err := CreateIssuanceID(testAddress, 1)
+	if err != nil {
+		t.Fatalf("failed to create issuance id: %v", err)
+	}
+	assert.NoError(t, NewDebtMPToken(collateral, testAddress, creditor).Validate())
+
+	tests := []struct {
+		name    string
+		token   DebtMPToken
+		wantErr error
+	}{
+		{name: "invalid collateral", token: NewDebtMPToken("00", testAddress, creditor), wantErr: ErrInvalidIssuanceID},
+		{name: "invalid owner", token: NewDebtMPToken(collateral, "rInvalid", creditor), wantErr: ErrInvalidIssuer},
+		{name: "invalid creditor", token: NewDebtMPToken(collateral, testAddress, "rInvalid"), wantErr: ErrInvalidCreditor},
+		{name: "empty creditor", token: NewDebtMPToken(collateral, testAddress, ""), wantErr: ErrInvalidCreditor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.token.Validate()
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
+
+func TestBlockchain_MPTokenIssuanceCreate_Invalid(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, _, err := bc.MPTokenIssuanceCreate(bc.w, NewWarrantMPToken("", testAddress))
+	assert.True(t, errors.Is(err, ErrInvalidDocumentHash))
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_MPTokenIssuanceCreate_Flags(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []IssuanceOption
+		flags uint32
+	}{
+		{name: "default escrow, trade and transfer", flags: 0x08 | 0x10 | 0x20},
+		{name: "clawback and lock", opts: []IssuanceOption{WithMPTFlags(MPTFlags{CanLock: true, CanClawback: true})}, flags: 0x02 | 0x40},
+		{name: "transfer only", opts: []IssuanceOption{WithMPTFlags(MPTFlags{CanTransfer: true})}, flags: 0x20},
+		{name: "escrow without transfer", opts: []IssuanceOption{WithMPTFlags(MPTFlags{CanEscrow: true})}, flags: 0x08},
+		{name: "none", opts: []IssuanceOption{WithMPTFlags(MPTFlags{})}, flags: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+			bc.SetSubmitMode(WaitForValidation)
+
+			_, _, err := bc.MPTokenIssuanceCreate(bc.w, NewWarrantMPToken("abcdef", testAddress), tt.opts...)
+			assert.NoError(t, err)
+
+			tx := submittedTx(t, node, 0)
+			assert.Equal(t, "MPTokenIssuanceCreate", tx["TransactionType"])
+			// The submitted transaction only carries the requested flags
+			flags, _ := tx["Flags"].(uint32)
+			assert.Equal(t, tt.flags, flags)
+		})
+	}
+}
+
+func TestDecodeIssuanceID(t *testing.T) {
+	id, err := CreateIssuanceID(testAddress, 7)
+	if err != nil {
+		t.Fatalf("failed to create issuance id: %v", err)
+	}
+
+	issuer, sequence, err := DecodeIssuanceID(id)
+	assert.NoError(t, err)
+	assert.Equal(t, testAddress, issuer)
+	assert.Equal(t, uint32(7), sequence)
+
+	_, _, err = DecodeIssuanceID(id[:46])
+	assert.True(t, errors.Is(err, ErrInvalidIssuanceID), "got %v", err)
+}
+
+func TestWarrantMPToken_MetadataURI(t *testing.T) {
+	const uri = "https://cdn.fortstock.io/warrants/abcdef.json"
+	inline := NewWarrantMPToken("abcdef", testAddress)
+	external := inline.WithMetadataURI(uri)
+	assert.NoError(t, external.Validate())
+
+	inlineMD, err := inline.CreateMetadata()
+	assert.NoError(t, err)
+	inlineBlob, err := inlineMD.GetBlob()
+	assert.NoError(t, err)
+
+	md, err := external.CreateMetadata()
+	assert.NoError(t, err)
+	blob, err := md.GetBlob()
+	assert.NoError(t, err)
+	assert.Less(t, len(blob), len(inlineBlob))
+
+	decoded, err := NewMPTokenMetadataFromBlob(blob)
+	if assert.NoError(t, err) {
+		assert.NoError(t, decoded.Validate())
+		assert.Equal(t, []MPTokenMetadataUrl{{Url: uri, Type: "document", Title: "Metadata"}}, decoded.Urls)
+		assert.JSONEq(t, `{"document_hash":"abcdef"}`, string(decoded.AdditionalInfo))
+	}
+
+	for _, invalid := range []string{"http://cdn.fortstock.io/a.json", "https://", "cdn.fortstock.io/a.json", "::"} {
+		err := inline.WithMetadataURI(invalid).Validate()
+		assert.True(t, errors.Is(err, ErrInvalidMetadataURI), "uri %q: got %v", invalid, err)
+	}
+	assert.NoError(t, inline.WithMetadataURI("ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi").Validate())
+}
+
+func TestWarrantMPToken_MetadataDefaults(t *testing.T) {
+	defaults := config.MetadataDefaults{
+		Ticker:  "ACMEW",
+		Name:    "Acme Warrant",
+		HomeURL: "https://acme.example",
+	}
+	mpt := NewWarrantMPToken("abcdef", testAddress).WithMetadataDefaults(defaults)
+	assert.NoError(t, mpt.Validate())
+
+	md, err := mpt.CreateMetadata()
+	assert.NoError(t, err)
+	blob, err := md.GetBlob()
+	assert.NoError(t, err)
+
+	decoded, err := NewMPTokenMetadataFromBlob(blob)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ACMEW", decoded.Ticker)
+		assert.Equal(t, "Acme Warrant", decoded.Name)
+		assert.Equal(t, defaultWarrantMetadata.Desc, decoded.Desc)
+		assert.Equal(t, []MPTokenMetadataUrl{
+			{Url: "https://acme.example", Type: "website", Title: "Home"},
+			{Url: defaultWarrantMetadata.RulebookURL, Type: "document", Title: "Legal framework"},
+		}, decoded.Urls)
+	}
+
+	for _, ticker := range []string{"TOOLONG", "acme", "AC-ME"} {
+		err := mpt.WithMetadataDefaults(config.MetadataDefaults{Ticker: ticker}).Validate()
+		assert.True(t, errors.Is(err, config.ErrInvalidMetadataConfig), "ticker %q: got %v", ticker, err)
+	}
+}
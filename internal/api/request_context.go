@@ -0,0 +1,87 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+)
+
+// requestContext holds the context bound to the rpc requests of the lock holder.
+// The rpc client has no context support, so cancellation is applied to its HTTP requests.
+type requestContext struct {
+	ctx atomic.Pointer[context.Context]
+}
+
+func (r *requestContext) get() context.Context {
+	if r == nil {
+		return nil
+	}
+	if ctx := r.ctx.Load(); ctx != nil {
+		return *ctx
+	}
+	return nil
+}
+
+func (r *requestContext) set(ctx context.Context) {
+	if r == nil {
+		return
+	}
+	if ctx == nil {
+		r.ctx.Store(nil)
+		return
+	}
+	r.ctx.Store(&ctx)
+}
+
+// contextHTTPClient aborts the requests of the rpc client once the bound context is done.
+type contextHTTPClient struct {
+	rpc.HTTPClient
+	reqCtx *requestContext
+}
+
+func (c *contextHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := c.reqCtx.get()
+	if ctx == nil {
+		return c.HTTPClient.Do(req)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// The response body is read after Do returns, so the request is only cancelled
+	// when the bound context is done, and the hook is dropped with the request.
+	httpCtx, cancel := context.WithCancel(req.Context())
+	stop := context.AfterFunc(ctx, cancel)
+	context.AfterFunc(httpCtx, func() { stop() })
+
+	return c.HTTPClient.Do(req.WithContext(httpCtx))
+}
+
+// SetContext binds the rpc requests issued until Unlock, or until it is called again, to the
+// context. Once the context is done, in-flight requests are aborted and further requests fail,
+// so a blocked operation returns instead of holding the lock. The reads of background polling,
+// such as WatchAccount and AwaitValidation, are not bound. The caller must hold the lock.
+//
+// Parameters:
+// - ctx: The context bounding the operation, usually carrying a deadline
+func (b *Blockchain) SetContext(ctx context.Context) {
+	b.reqCtx.set(ctx)
+}
+
+// boundContext returns the context bound by SetContext, or a background context if none is.
+func (b *Blockchain) boundContext() context.Context {
+	if ctx := b.reqCtx.get(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// pollClient returns the client of background polling, not bound by SetContext.
+func (b *Blockchain) pollClient() *rpc.Client {
+	if b.poll != nil {
+		return b.poll
+	}
+	return b.c
+}
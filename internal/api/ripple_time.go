@@ -0,0 +1,16 @@
+package api
+
+import (
+	rippletime "github.com/Peersyst/xrpl-go/xrpl/time"
+)
+
+// RippleTimeToUnix converts a time in seconds since the Ripple Epoch (2000-01-01T00:00:00Z),
+// as the ledger writes the close time of transactions, to seconds since the Unix epoch.
+//
+// Parameters:
+// - rippleSeconds: The seconds since the Ripple Epoch
+//
+// Returns the seconds since the Unix epoch.
+func RippleTimeToUnix(rippleSeconds uint32) uint64 {
+	return uint64(rippleSeconds) + uint64(rippletime.RippleEpochDiff)
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	tokenv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/token/v1"
+)
+
+func TestRippleTimeToUnix(t *testing.T) {
+	assert.Equal(t, uint64(946684800), RippleTimeToUnix(0))
+	// 2023-10-07T13:20:00Z
+	assert.Equal(t, uint64(1696684800), RippleTimeToUnix(750000000))
+	assert.Equal(t, time.Date(2023, 10, 7, 13, 20, 0, 0, time.UTC).Unix(), int64(RippleTimeToUnix(750000000)))
+}
+
+func TestToken_TransactionInfo_BlockTime(t *testing.T) {
+	bc, _ := newTestBlockchain(t, map[string]rpcHandler{
+		"tx": fixtureResult(mptAuthorizeTxFixture),
+	})
+	tokenAPI := createTestToken(bc)
+
+	resp, err := tokenAPI.TransactionInfo(context.Background(), &tokenv1.TransactionInfoRequest{
+		TransactionId: "E08D6E9754025BA2534A78707605E0601F03ACE063687A0CA1BDDACFCD1698C7",
+	})
+	if assert.NoError(t, err) {
+		// The fixture closed at Ripple time 750000000
+		assert.Equal(t, uint64(1696684800), resp.GetTransaction().GetBlockTime())
+	}
+}
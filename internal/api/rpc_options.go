@@ -0,0 +1,35 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+)
+
+// WithHeader returns an rpc.ConfigOpt that sets an HTTP header on every JSON-RPC request.
+// Hosted XRPL providers commonly require an API key passed this way.
+//
+// Parameters:
+// - key: The header name
+// - value: The header value
+//
+// Returns a config option to be passed to rpc.NewClientConfig.
+func WithHeader(key, value string) rpc.ConfigOpt {
+	return func(c *rpc.Config) {
+		if c.Headers == nil {
+			c.Headers = make(map[string][]string)
+		}
+		http.Header(c.Headers).Set(key, value)
+	}
+}
+
+// WithBearerToken returns an rpc.ConfigOpt that sets the Authorization header
+// with the given bearer token on every JSON-RPC request.
+//
+// Parameters:
+// - token: The bearer token
+//
+// Returns a config option to be passed to rpc.NewClientConfig.
+func WithBearerToken(token string) rpc.ConfigOpt {
+	return WithHeader("Authorization", "Bearer "+token)
+}
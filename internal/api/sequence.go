@@ -0,0 +1,204 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+
+	account "github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/queries/common"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// sequenceManager hands out account sequence numbers for the system wallet so that
+// concurrent submissions do not autofill the same Sequence from account_info.
+// Submissions of an account are serialized between acquire and release, submissions
+// of different accounts are independent. The zero value is ready to use.
+type sequenceManager struct {
+	mu       sync.Mutex
+	accounts map[string]*accountSequence
+}
+
+type accountSequence struct {
+	mu    sync.Mutex
+	next  uint32
+	known bool
+	// fetches counts the reads of the sequence from the node, so that a reservation
+	// only hands its sequence back while next still derives from the same read.
+	fetches uint64
+}
+
+// account returns the sequence state of the account, creating it on first use.
+func (m *sequenceManager) account(address string) *accountSequence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.accounts == nil {
+		m.accounts = make(map[string]*accountSequence)
+	}
+	seq, ok := m.accounts[address]
+	if !ok {
+		seq = &accountSequence{}
+		m.accounts[address] = seq
+	}
+	return seq
+}
+
+// load fetches the next sequence from the node when it is not known. The caller holds seq.mu.
+func (seq *accountSequence) load(fetch func() (uint32, error)) error {
+	if seq.known {
+		return nil
+	}
+	next, err := fetch()
+	if err != nil {
+		return err
+	}
+	seq.next = next
+	seq.known = true
+	seq.fetches++
+	return nil
+}
+
+// acquire locks the account's sequence and returns the next one to use, fetching it
+// from the node when it is not known. The returned release must be called once the
+// transaction has been submitted; consumed reports whether the node accepted it.
+func (m *sequenceManager) acquire(address string, fetch func() (uint32, error)) (
+	sequence uint32, release func(consumed bool), err error) {
+	seq := m.account(address)
+	seq.mu.Lock()
+	if err := seq.load(fetch); err != nil {
+		seq.mu.Unlock()
+		return 0, nil, err
+	}
+
+	release = func(consumed bool) {
+		if consumed {
+			seq.next++
+		} else {
+			// The node may or may not have used the sequence, read it again next time
+			seq.known = false
+		}
+		seq.mu.Unlock()
+	}
+	return seq.next, release, nil
+}
+
+// reserve hands out the next sequence of the account without keeping it locked, for a
+// transaction signed now and submitted later: the following submissions of the account
+// take the sequences after it. The returned release must be called once the transaction
+// has been submitted or dropped; consumed reports whether the node accepted it. A dropped
+// sequence is handed out again if no later one was, otherwise the sequence is read again
+// from the node, which also covers a signed transaction that is never submitted.
+func (m *sequenceManager) reserve(address string, fetch func() (uint32, error)) (
+	sequence uint32, release func(consumed bool), err error) {
+	seq := m.account(address)
+	seq.mu.Lock()
+	defer seq.mu.Unlock()
+	if err := seq.load(fetch); err != nil {
+		return 0, nil, err
+	}
+
+	sequence = seq.next
+	fetches := seq.fetches
+	seq.next++
+
+	var once sync.Once
+	release = func(consumed bool) {
+		once.Do(func() {
+			if consumed {
+				return
+			}
+			seq.mu.Lock()
+			defer seq.mu.Unlock()
+			if seq.known && seq.fetches == fetches && seq.next == sequence+1 {
+				seq.next = sequence
+			} else {
+				seq.known = false
+			}
+		})
+	}
+	return sequence, release, nil
+}
+
+// forget drops the known sequence of the account so it is fetched from the node on next use.
+func (m *sequenceManager) forget(address string) {
+	m.mu.Lock()
+	seq, ok := m.accounts[address]
+	m.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	seq.mu.Lock()
+	seq.known = false
+	seq.mu.Unlock()
+}
+
+// heldSequences keeps the sequence reservations of transactions signed with SignTx until
+// their blob is submitted or discarded, keyed by transaction hash. The zero value is ready to use.
+type heldSequences struct {
+	mu       sync.Mutex
+	releases map[string]func(consumed bool)
+}
+
+// hold keeps the release of the reservation of a signed transaction.
+func (h *heldSequences) hold(txHash string, release func(consumed bool)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.releases == nil {
+		h.releases = make(map[string]func(consumed bool))
+	}
+	h.releases[txHash] = release
+}
+
+// take removes and returns the release of the reservation of a signed transaction, if any.
+func (h *heldSequences) take(txHash string) (release func(consumed bool), ok bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	release, ok = h.releases[txHash]
+	delete(h.releases, txHash)
+	return release, ok
+}
+
+// reserveSequence sets the Sequence of a system wallet transaction from the sequence manager,
+// keeping the account's sequence locked until the returned release is called.
+// Transactions of other wallets, or with an explicit Sequence, are left to autofill and
+// the returned release is a no-op.
+func (b *Blockchain) reserveSequence(tx map[string]any) (release func(consumed bool), err error) {
+	return b.setSystemSequence(tx, b.sequences.acquire)
+}
+
+// reserveSignedSequence sets the Sequence of a system wallet transaction signed to be
+// submitted later, like reserveSequence, without keeping the account's sequence locked.
+func (b *Blockchain) reserveSignedSequence(tx map[string]any) (release func(consumed bool), err error) {
+	return b.setSystemSequence(tx, b.sequences.reserve)
+}
+
+// setSystemSequence sets the Sequence of a system wallet transaction from the given
+// sequence manager method.
+func (b *Blockchain) setSystemSequence(tx map[string]any,
+	take func(address string, fetch func() (uint32, error)) (uint32, func(consumed bool), error)) (
+	release func(consumed bool), err error) {
+	address, _ := tx["Account"].(string)
+	system := b.SystemWallet()
+	if _, ok := tx["Sequence"]; ok || system == nil || address != system.ClassicAddress.String() {
+		return func(bool) {}, nil
+	}
+
+	sequence, release, err := take(address, func() (uint32, error) {
+		info, err := b.c.GetAccountInfo(&account.InfoRequest{
+			Account:     types.Address(address),
+			LedgerIndex: common.Current,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get account sequence: %w", err)
+		}
+		return info.AccountData.Sequence, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx["Sequence"] = sequence
+	return release, nil
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"fmt"
+
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// SubmitMode selects whether a submission returns once the node accepts the transaction
+// or once it is validated in a ledger.
+type SubmitMode int
+
+const (
+	// FireAndForget returns as soon as the node accepts the transaction.
+	FireAndForget SubmitMode = iota
+	// WaitForValidation returns once the transaction is validated, using the client's
+	// SubmitTxAndWait which manages LastLedgerSequence and confirmation.
+	WaitForValidation
+)
+
+// String returns the configuration name of the mode.
+func (m SubmitMode) String() string {
+	switch m {
+	case FireAndForget:
+		return "fire_and_forget"
+	case WaitForValidation:
+		return "wait_for_validation"
+	default:
+		return fmt.Sprintf("SubmitMode(%d)", int(m))
+	}
+}
+
+// ParseSubmitMode parses a submit mode from its configuration name.
+// An empty name selects FireAndForget.
+//
+// Parameters:
+// - name: "fire_and_forget" or "wait_for_validation"
+//
+// Returns the submit mode or an error for an unknown name.
+func ParseSubmitMode(name string) (SubmitMode, error) {
+	switch name {
+	case "", "fire_and_forget":
+		return FireAndForget, nil
+	case "wait_for_validation":
+		return WaitForValidation, nil
+	default:
+		return FireAndForget, fmt.Errorf("unknown submit mode: %q", name)
+	}
+}
+
+// SetSubmitMode sets the mode used by flows that submit and then need the outcome,
+// such as MPTokenIssuanceCreate.
+func (b *Blockchain) SetSubmitMode(mode SubmitMode) {
+	b.submitMode = mode
+}
+
+// SubmitWithMode submits a transaction in the given mode.
+//
+// Parameters:
+// - w: The wallet used to sign the transaction
+// - tx: The transaction to submit
+// - mode: FireAndForget or WaitForValidation
+//
+// Returns the transaction hash and, for WaitForValidation, the validated transaction response
+// (nil for FireAndForget), or an error if the submission fails.
+func (b *Blockchain) SubmitWithMode(w *wallet.Wallet, tx SubmittableTransaction, mode SubmitMode) (
+	txHash string, resp *requests.TxResponse, err error) {
+	switch mode {
+	case FireAndForget:
+		txHash, err = b.SubmitTx(w, tx)
+		return txHash, nil, err
+	case WaitForValidation:
+		resp, err = b.submitAndWait(w, tx)
+		if err != nil {
+			return "", nil, err
+		}
+		return string(resp.Hash), resp, nil
+	default:
+		return "", nil, fmt.Errorf("unknown submit mode: %s", mode)
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSubmitMode(t *testing.T) {
+	for _, mode := range []SubmitMode{FireAndForget, WaitForValidation} {
+		parsed, err := ParseSubmitMode(mode.String())
+		assert.NoError(t, err)
+		assert.Equal(t, mode, parsed)
+	}
+
+	mode, err := ParseSubmitMode("")
+	assert.NoError(t, err)
+	assert.Equal(t, FireAndForget, mode)
+
+	_, err = ParseSubmitMode("sometimes")
+	assert.Error(t, err)
+}
+
+func TestBlockchain_SubmitWithMode_FireAndForget(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	hash, resp, err := bc.SubmitWithMode(bc.w, &transaction.AccountSet{}, FireAndForget)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	assert.Nil(t, resp)
+	assert.Equal(t, 1, node.Calls("submit"))
+	assert.Equal(t, 0, node.Calls("tx"))
+}
+
+func TestBlockchain_SubmitWithMode_WaitForValidation(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	hash, resp, err := bc.SubmitWithMode(bc.w, &transaction.AccountSet{}, WaitForValidation)
+	assert.NoError(t, err)
+	if assert.NotNil(t, resp) {
+		assert.Equal(t, hash, string(resp.Hash))
+		assert.True(t, resp.Validated)
+		assert.Equal(t, "AccountSet", resp.TxJson["TransactionType"])
+	}
+	assert.Equal(t, 1, node.Calls("submit"))
+	assert.Equal(t, 1, node.Calls("tx"))
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, uint32(120), tx["LastLedgerSequence"])
+}
+
+func TestBlockchain_MPTokenIssuanceCreate_WaitForValidation(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetSubmitMode(WaitForValidation)
+
+	hash, issuanceID, err := bc.MPTokenIssuanceCreate(bc.w, NewWarrantMPToken("abcdef", testAddress))
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+
+	expected, _ := CreateIssuanceID(testAddress, 10)
+	assert.Equal(t, expected, issuanceID)
+	assert.Equal(t, 1, node.Calls("tx"))
+}
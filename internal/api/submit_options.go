@@ -0,0 +1,92 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+var (
+	// ErrInvalidNetworkID is returned when a NetworkID override targets a network that must not carry one.
+	ErrInvalidNetworkID = errors.New("invalid network ID")
+)
+
+// submitOptions holds the settings of a single submission.
+type submitOptions struct {
+	// networkID overrides the client-wide NetworkID when set.
+	networkID *uint32
+	// accountTxnID chains the transaction to the previous one of the account.
+	accountTxnID bool
+	// sourceTag sets the SourceTag of the transaction when set.
+	sourceTag *uint32
+	// sequence sets the Sequence of the transaction instead of the sequence manager or autofill.
+	sequence *uint32
+	// failHard asks the node not to retry or relay the transaction if it fails locally.
+	failHard bool
+}
+
+// SubmitOption configures a single SubmitTx, SubmitTxWithSequence or SubmitTxAndWait call.
+type SubmitOption func(*submitOptions)
+
+// WithNetworkID sets the NetworkID of the transaction, overriding the client-wide value
+// for this submission only. Only networks above rpc.RestrictedNetworks carry a NetworkID.
+//
+// Parameters:
+// - networkID: The ID of the network the transaction targets
+func WithNetworkID(networkID uint32) SubmitOption {
+	return func(o *submitOptions) {
+		o.networkID = &networkID
+	}
+}
+
+// WithSourceTag sets the SourceTag of the transaction, identifying the originator of a payment
+// on behalf of which the account sends it, such as an internal ledger of the service.
+// Any value, including zero, is carried as is.
+//
+// Parameters:
+// - tag: The source tag
+func WithSourceTag(tag uint32) SubmitOption {
+	return func(o *submitOptions) {
+		o.sourceTag = &tag
+	}
+}
+
+// WithFailHard submits the transaction with fail_hard, for critical transactions such as
+// an AccountDelete whose outcome must be known synchronously. By default the node may keep
+// a transaction that fails its local checks with a retriable result and relay it later,
+// so it can still succeed, or fail, after the submission returned. With fail_hard the node
+// drops it instead: the submission result is final, at the cost of not retrying a
+// transaction that would have succeeded in a later ledger.
+func WithFailHard() SubmitOption {
+	return func(o *submitOptions) {
+		o.failHard = true
+	}
+}
+
+// newSubmitOptions applies the options and validates the result.
+func newSubmitOptions(opts []SubmitOption) (submitOptions, error) {
+	var o submitOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.networkID != nil && *o.networkID <= rpc.RestrictedNetworks {
+		return o, fmt.Errorf("%w: %d must be above %d", ErrInvalidNetworkID, *o.networkID, rpc.RestrictedNetworks)
+	}
+	return o, nil
+}
+
+// applyTo sets the overridden fields on the transaction. The client's autofill only fills
+// NetworkID when it is missing, so a value written here takes precedence.
+func (o submitOptions) applyTo(tx transactions.FlatTransaction) {
+	if o.networkID != nil {
+		tx["NetworkID"] = *o.networkID
+	}
+	if o.sourceTag != nil {
+		tx["SourceTag"] = *o.sourceTag
+	}
+	if o.sequence != nil {
+		tx["Sequence"] = *o.sequence
+	}
+}
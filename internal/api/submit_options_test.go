@@ -0,0 +1,147 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/rpc"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_SubmitTx_WithNetworkID(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.c.NetworkID = 2000
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{}, WithNetworkID(3000))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3000), submittedTx(t, node, 0)["NetworkID"])
+	assert.Equal(t, uint32(2000), bc.c.NetworkID)
+
+	_, err = bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{}, WithNetworkID(3001))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(3001), submittedTx(t, node, 1)["NetworkID"])
+
+	// Without the option the client-wide value is used
+	_, err = bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2000), submittedTx(t, node, 2)["NetworkID"])
+}
+
+func TestBlockchain_SubmitTx_WithRestrictedNetworkID(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{}, WithNetworkID(rpc.RestrictedNetworks))
+	assert.True(t, errors.Is(err, ErrInvalidNetworkID), "got %v", err)
+
+	_, _, err = bc.SubmitTxWithSequence(bc.w, &transaction.AccountSet{}, WithNetworkID(1))
+	assert.True(t, errors.Is(err, ErrInvalidNetworkID), "got %v", err)
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestBlockchain_SubmitTx_WithAccountTxnID(t *testing.T) {
+	const ledgerTxnID = "0D5FB50FA65C9FE1538FD7E398FFFE9D1908DFA4576D8D7A020040686F93C77D"
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["account_info"] = func(params map[string]any) map[string]any {
+		result := accountInfoResult(testAddress, 0, "1000000000")(params)
+		result["account_data"].(map[string]any)["AccountTxnID"] = ledgerTxnID
+		return result
+	}
+	bc, node := newTestBlockchain(t, handlers)
+
+	first, err := bc.SubmitTx(bc.w, &transaction.AccountSet{}, WithAccountTxnID())
+	assert.NoError(t, err)
+	assert.Equal(t, ledgerTxnID, submittedTx(t, node, 0)["AccountTxnID"])
+
+	// An unchained submission still moves the tracked hash forward
+	second, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.NotContains(t, submittedTx(t, node, 1), "AccountTxnID")
+
+	_, err = bc.SubmitTx(bc.w, &transaction.AccountSet{}, WithAccountTxnID())
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, second, submittedTx(t, node, 2)["AccountTxnID"])
+}
+
+func TestBlockchain_SubmitTx_WithAccountTxnID_Disabled(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{}, WithAccountTxnID())
+	assert.True(t, errors.Is(err, ErrAccountTxnIDDisabled), "got %v", err)
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestBlockchain_SubmitTx_WithAccountTxnID_Failure(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tefWRONG_PRIOR"))
+	bc.txnIDs.record(bc.w.ClassicAddress.String(), "0D5FB50FA65C9FE1538FD7E398FFFE9D1908DFA4576D8D7A020040686F93C77D", true)
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{}, WithAccountTxnID())
+	assert.Error(t, err)
+
+	// The chain is read from the ledger again next time
+	_, ok := bc.txnIDs.get(bc.w.ClassicAddress.String())
+	assert.False(t, ok)
+}
+
+func TestBlockchain_WithSourceTag(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.PaymentXRP(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 1000, WithSourceTag(4242))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(4242), submittedTx(t, node, 0)["SourceTag"])
+
+	issuanceID, _ := CreateIssuanceID(testAddress, 7)
+	_, err = bc.TransferMPToken(bc.w, issuanceID, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe",
+		WithSubmitOptions(WithSourceTag(0)))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), submittedTx(t, node, 1)["SourceTag"])
+
+	_, err = bc.PaymentXRP(bc.w, "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 1000)
+	assert.NoError(t, err)
+	assert.NotContains(t, submittedTx(t, node, 2), "SourceTag")
+}
+
+func TestBlockchain_SubmitTxWithExplicitSequence(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	// A block of sequences ahead of the account's next one, 10 in the stub
+	for _, sequence := range []uint32{42, 43} {
+		_, err := bc.SubmitTxWithExplicitSequence(bc.w, &transaction.AccountSet{}, sequence)
+		assert.NoError(t, err)
+	}
+	for i, sequence := range []uint32{42, 43} {
+		tx := submittedTx(t, node, i)
+		assert.Equal(t, sequence, tx["Sequence"])
+		assert.NotEmpty(t, tx["Fee"])
+		assert.NotEmpty(t, tx["LastLedgerSequence"])
+	}
+
+	// The sequence manager reads the next sequence from the node again
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(10), submittedTx(t, node, 2)["Sequence"])
+
+	_, err = bc.SubmitTxWithExplicitSequence(bc.w, &transaction.AccountSet{}, 0)
+	assert.True(t, errors.Is(err, ErrInvalidSequence), "got %v", err)
+	assert.Equal(t, 3, node.Calls("submit"))
+}
+
+func TestBlockchain_SubmitTx_WithFailHard(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{}, WithFailHard())
+	assert.NoError(t, err)
+	_, _, err = bc.SubmitTxWithSequence(bc.w, &transaction.AccountSet{}, WithFailHard())
+	assert.NoError(t, err)
+	_, err = bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+
+	params := node.Params("submit")
+	if assert.Len(t, params, 3) {
+		assert.Equal(t, true, params[0]["fail_hard"])
+		assert.Equal(t, true, params[1]["fail_hard"])
+		// Without the option the node may retry the transaction
+		assert.NotEqual(t, true, params[2]["fail_hard"])
+	}
+}
@@ -4,6 +4,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -27,24 +28,95 @@ type Token struct {
 	logger   *slog.Logger
 	features *config.FeatureConfig
 	loans    *Loans
+
+	issuances IssuanceRegistry
+	metadata  config.MetadataDefaults
+
+	// emissionTimeout bounds a whole emission, zero means no bound.
+	emissionTimeout time.Duration
 }
 
 // NewToken creates and returns a new Token API server instance.
-// It requires a logger and blockchain instance for operation.
+// It requires a logger and blockchain instance for operation. The feature flags are kept
+// as given; with the loan feature on, loans are tracked and their interest paid on bc.
+// Emitted documents are tracked in memory; use SetIssuanceRegistry to replace the registry.
+// Warrants carry the built-in metadata; use SetMetadataDefaults to configure it.
+// Emissions are bounded by the configured emission timeout.
 func NewToken(logger *slog.Logger, bc *Blockchain, features *config.FeatureConfig) *Token {
 	var loans *Loans
 	if features.Loan {
-		loans = NewLoans(logger, bc)
+		loans = NewLoans(logger, bc, time.Duration(features.LoanPaymentTimeout)*time.Second)
 	} else {
 		loans = &Loans{}
 	}
 
 	return &Token{
-		logger:   logger,
-		bc:       bc,
-		features: features,
-		loans:    loans,
+		logger:    logger,
+		bc:        bc,
+		features:  features,
+		loans:     loans,
+		issuances: NewMemoryIssuanceRegistry(),
+
+		emissionTimeout: time.Duration(features.EmissionTimeout) * time.Second,
+	}
+}
+
+// SetEmissionTimeout sets the deadline of a whole emission. Once it passes, the remaining
+// steps are aborted and the emission fails with DeadlineExceeded.
+//
+// Parameters:
+// - timeout: The deadline of an emission, zero disables it
+func (t *Token) SetEmissionTimeout(timeout time.Duration) {
+	t.emissionTimeout = timeout
+}
+
+// SetMetadataDefaults sets the ticker, name, description and links of the metadata of
+// emitted warrants.
+//
+// Parameters:
+// - defaults: The configured metadata defaults; empty fields keep the built-in values
+func (t *Token) SetMetadataDefaults(defaults config.MetadataDefaults) {
+	t.metadata = defaults
+}
+
+// blockTime returns the close time of the ledger of a transaction as Unix seconds. Only
+// transfers that wait for validation are in a closed ledger by the time their response is
+// built; for the others, or if the lookup fails, the current time stands in.
+func (t *Token) blockTime(hash string) uint64 {
+	if t.features.WaitForValidation {
+		resp, _, _, err := t.bc.GetTransactionInfo(hash)
+		if err == nil && resp.Validated && resp.Date != 0 {
+			return RippleTimeToUnix(uint32(resp.Date))
+		}
+	}
+	return uint64(time.Now().Unix())
+}
+
+// transferOptions returns the options of token transfers selected by the feature flags.
+func (t *Token) transferOptions() []TransferOption {
+	var opts []TransferOption
+	if !t.features.RequireBalanceCheck {
+		opts = append(opts, WithoutBalanceCheck())
+	}
+	if t.features.WaitForValidation {
+		opts = append(opts, WithValidation())
 	}
+	return opts
+}
+
+// verifyRequestSignature checks the signature of a request to run the operation on a document
+// against the configured request signer key, see crypto.RequestMessage for the signed message.
+// Requests are not verified when no signer key is configured.
+//
+// Returns a codes.PermissionDenied error if the signature does not match.
+func (t *Token) verifyRequestSignature(operation, docHash, signature string) error {
+	if t.features.RequestSignerKey == "" {
+		return nil
+	}
+	if err := crypto.VerifyRequestSignature(operation, docHash, signature, t.features.RequestSignerKey); err != nil {
+		return status.Errorf(codes.PermissionDenied, "request signature rejected: %v", err)
+	}
+	return nil
 }
 
 // CreateContract is not available for XRPL and returns an error response.
@@ -75,18 +147,49 @@ func (t *Token) CreateContract(ctx context.Context, req *tokenv1.CreateContractR
 // - req.Signature: The signature authorizing the token creation
 // - req.WarehousePass: The warehouse password in format "hexSeed-derivationIndex"
 //
+// A document hash that was already emitted is rejected with codes.AlreadyExists,
+// naming the prior issuance ID. A document is registered as emitted only once its
+// token was transferred to the owner; a failure to register it is returned as
+// codes.Internal.
+//
 // Returns the created token information including issuance ID and transaction details.
+// The transaction is the transfer to the owner; its "Emission" event carries the hashes
+// of the issuance creation, the owner authorization and the transfer.
 func (t *Token) Emission(ctx context.Context, req *tokenv1.EmissionRequest) (*tokenv1.EmissionResponse, error) {
-	l := t.logger.With("method", "Emission",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "Emission", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"warehouse_id", req.GetWarehouseAddressId(),
 		"owner_address_id", req.GetOwnerAddressId())
 	l.Debug("start", "owner_address_id", req.GetOwnerAddressId())
-	t.bc.Lock()
+	if err := t.verifyRequestSignature("Emission", req.GetDocumentHash(), req.GetSignature()); err != nil {
+		l.Error("request signature rejected", "error", err)
+		return nil, err
+	}
+	deadline, cancel := t.emissionContext(ctx)
+	defer cancel()
+	if err := t.bc.TryLock(deadline); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
+	// Abort the remaining steps once the deadline passes so the lock is released
+	t.bc.SetContext(deadline)
+
+	if t.issuances != nil {
+		prior, found, err := t.issuances.Lookup(ctx, documentKey(req.GetDocumentHash()))
+		if err != nil {
+			l.Error("failed to look up document issuance", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to look up document issuance: %v", err)
+		}
+		if found {
+			l.Error("document already emitted", "issuance_id", prior)
+			return nil, status.Errorf(codes.AlreadyExists, "document already emitted as issuance %s", prior)
+		}
+	}
 
-	seeds := strings.Split(req.GetWarehousePass(), "-")
-	warehouse, err := crypto.NewWalletFromHexSeed(seeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", seeds[1]))
+	warehouse, err := t.bc.WalletFromPass(req.GetWarehousePass())
 	if err != nil {
 		l.Error("failed to create wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create wallet: %v", err)
@@ -99,8 +202,7 @@ func (t *Token) Emission(ctx context.Context, req *tokenv1.EmissionRequest) (*to
 	if req.GetOwnerPass() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "owner pass is required")
 	}
-	ownerSeeds := strings.Split(req.GetOwnerPass(), "-")
-	owner, err := crypto.NewWalletFromHexSeed(ownerSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", ownerSeeds[1]))
+	owner, err := t.bc.WalletFromPass(req.GetOwnerPass())
 	if err != nil {
 		l.Error("failed to create owner wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create owner wallet: %v", err)
@@ -111,24 +213,47 @@ func (t *Token) Emission(ctx context.Context, req *tokenv1.EmissionRequest) (*to
 	}
 
 	l.Debug("issuing mpt token")
-	mpt := NewWarrantMPToken(req.GetDocumentHash(), warehouse.ClassicAddress.String())
-	hash, issuanceID, err := t.bc.MPTokenIssuanceCreate(warehouse, mpt)
+	mpt := NewWarrantMPToken(req.GetDocumentHash(), warehouse.ClassicAddress.String()).WithMetadataDefaults(t.metadata)
+	createHash, issuanceID, err := t.bc.MPTokenIssuanceCreate(warehouse, mpt)
+	if errors.Is(err, ErrInvalidDocumentHash) || errors.Is(err, ErrInvalidIssuer) {
+		l.Error("invalid token", "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %v", err)
+	}
 	if err != nil {
-		l.Error("failed to create issuance", "hash", hash, "error", err)
+		l.Error("failed to create issuance", "hash", createHash, "error", err)
+		if st := emissionDeadlineStatus(deadline, "none"); st != nil {
+			return nil, st
+		}
 		return nil, status.Errorf(codes.Internal, "failed to create issuance: %v", err)
 	}
 
 	l.Debug("authorizing token", "issuance_id", issuanceID)
-	err = t.bc.AuthorizeMPToken(owner, issuanceID)
+	authorizeHash, err := t.bc.AuthorizeMPTokenWithHash(owner, issuanceID)
 	if err != nil {
-		l.Warn("failed to authorize token", "error", err)
+		l.Error("failed to authorize token", "error", err)
+		if st := emissionDeadlineStatus(deadline, "issuance "+issuanceID+" created"); st != nil {
+			return nil, st
+		}
+		return nil, status.Errorf(codes.Internal, "failed to authorize token: %v", err)
 	}
 
 	l.Debug("transferring token to owner", "issuance_id", issuanceID)
-	hash, err = t.bc.TransferMPToken(warehouse, issuanceID, owner.ClassicAddress.String())
+	hash, err := t.bc.TransferMPToken(warehouse, issuanceID, owner.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "hash", hash, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		if st := emissionDeadlineStatus(deadline, "owner authorized for issuance "+issuanceID); st != nil {
+			return nil, st
+		}
+		return nil, transferStatus("failed to transfer token", err)
+	}
+
+	// The document is registered once the token reached its owner, so an emission that
+	// failed before can be retried.
+	if t.issuances != nil {
+		if err := t.issuances.Register(ctx, documentKey(req.GetDocumentHash()), issuanceID); err != nil {
+			l.Error("failed to register document issuance", "issuance_id", issuanceID, "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to register document issuance %s: %v", issuanceID, err)
+		}
 	}
 
 	return &tokenv1.EmissionResponse{
@@ -137,13 +262,74 @@ func (t *Token) Emission(ctx context.Context, req *tokenv1.EmissionRequest) (*to
 			Id: issuanceID,
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
+				Events:    []*typesv1.Event{emissionEvent(createHash, authorizeHash, hash)},
 			},
 		},
 	}, nil
 }
 
+// emissionContext returns the context bounding an emission by the emission timeout.
+func (t *Token) emissionContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if t.emissionTimeout > 0 {
+		return context.WithTimeout(ctx, t.emissionTimeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// emissionDeadlineStatus returns a DeadlineExceeded status naming the last completed step
+// if the emission failed because its deadline passed, or nil if it is still running.
+func emissionDeadlineStatus(ctx context.Context, completed string) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	return status.Errorf(codes.DeadlineExceeded, "emission deadline exceeded, last completed step: %s", completed)
+}
+
+// emissionEvent records the hashes of the transactions of an emission in an "Emission" event,
+// so the issuance creation can be audited apart from the transfer the response is named after.
+// The authorization hash is omitted when the owner was already authorized.
+func emissionEvent(createHash, authorizeHash, transferHash string) *typesv1.Event {
+	values := []*typesv1.EventValue{{Name: "issuance_create_hash", Value: createHash}}
+	if authorizeHash != "" {
+		values = append(values, &typesv1.EventValue{Name: "authorize_hash", Value: authorizeHash})
+	}
+	values = append(values, &typesv1.EventValue{Name: "transfer_hash", Value: transferHash})
+	return &typesv1.Event{Name: "Emission", Values: values}
+}
+
+// transferStatus maps a TransferMPToken error to a gRPC status: a sender holding
+// too little of the token or lacking the signer quorum is a failed precondition,
+// anything else is internal.
+func transferStatus(msg string, err error) error {
+	if errors.Is(err, ErrInsufficientMPTBalance) || errors.Is(err, ErrNoSignerList) ||
+		errors.Is(err, ErrSignerNotListed) || errors.Is(err, ErrQuorumNotMet) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
+
+// distinctParties checks the owner and the creditor of a creditor flow are different
+// accounts; a loan to oneself would only produce self-payments the ledger rejects.
+//
+// Returns an InvalidArgument status if they are the same account.
+func distinctParties(owner, creditor string) error {
+	if strings.EqualFold(owner, creditor) {
+		return status.Errorf(codes.InvalidArgument, "owner and creditor must be different accounts, got %s for both", owner)
+	}
+	return nil
+}
+
+// lockStatus maps a TryLock error to a gRPC status: the request gave up waiting
+// for another request holding the lock.
+func lockStatus(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return status.Errorf(codes.Canceled, "%v", err)
+	}
+	return status.Errorf(codes.DeadlineExceeded, "%v", err)
+}
+
 // Transfer transfers a Multi-Purpose Token from one account to another.
 // Both sender and recipient must be authorized to use the token.
 //
@@ -159,18 +345,30 @@ func (t *Token) Emission(ctx context.Context, req *tokenv1.EmissionRequest) (*to
 //
 // Returns the transfer response with transaction details.
 func (t *Token) Transfer(ctx context.Context, req *tokenv1.TransferRequest) (*tokenv1.TransferResponse, error) {
-	l := t.logger.With("method", "Transfer",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "Transfer", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"reciever_address_id", req.GetReceiverAddressId(),
 		"sender_address_id", req.GetSenderAddressId(),
 		"token_id", req.GetTokenId(),
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := ValidateIssuanceID(req.GetTokenId()); err != nil {
+		l.Error("invalid token id", "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token id: %v", err)
+	}
+	if err := t.verifyRequestSignature("Transfer", req.GetDocumentHash(), req.GetSignature()); err != nil {
+		l.Error("request signature rejected", "error", err)
+		return nil, err
+	}
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	recipientSeeds := strings.Split(req.GetReceiverPass(), "-")
-	recipient, err := crypto.NewWalletFromHexSeed(recipientSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", recipientSeeds[1]))
+	recipient, err := t.bc.WalletFromPass(req.GetReceiverPass())
 	if err != nil {
 		t.logger.Error("failed to create recipient wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create recipient wallet: %v", err)
@@ -180,8 +378,7 @@ func (t *Token) Transfer(ctx context.Context, req *tokenv1.TransferRequest) (*to
 		return nil, status.Errorf(codes.InvalidArgument, "recipient address does not match")
 	}
 
-	senderSeeds := strings.Split(req.GetSenderPass(), "-")
-	sender, err := crypto.NewWalletFromHexSeed(senderSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", senderSeeds[1]))
+	sender, err := t.bc.WalletFromPass(req.GetSenderPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -193,13 +390,14 @@ func (t *Token) Transfer(ctx context.Context, req *tokenv1.TransferRequest) (*to
 
 	err = t.bc.AuthorizeMPToken(recipient, req.GetTokenId())
 	if err != nil {
-		l.Warn("failed to authorize token", "error", err)
+		l.Error("failed to authorize token", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to authorize token: %v", err)
 	}
 
-	hash, err := t.bc.TransferMPToken(sender, req.GetTokenId(), recipient.ClassicAddress.String())
+	hash, err := t.bc.TransferMPToken(sender, req.GetTokenId(), recipient.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	return &tokenv1.TransferResponse{
@@ -208,7 +406,7 @@ func (t *Token) Transfer(ctx context.Context, req *tokenv1.TransferRequest) (*to
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
 			},
 		},
@@ -230,6 +428,19 @@ func (t *Token) Transfer(ctx context.Context, req *tokenv1.TransferRequest) (*to
 //
 // Returns the transfer response with transaction details.
 func (t *Token) TransferToCreditor(ctx context.Context, req *tokenv1.TransferToCreditorRequest) (*tokenv1.TransferToCreditorResponse, error) {
+	if err := ValidateIssuanceID(req.GetTokenId()); err != nil {
+		t.logger.Error("invalid token id", "method", "TransferToCreditor", "token_id", req.GetTokenId(), "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token id: %v", err)
+	}
+	if err := t.verifyRequestSignature("TransferToCreditor", req.GetDocumentHash(), req.GetSignature()); err != nil {
+		t.logger.Error("request signature rejected", "method", "TransferToCreditor", "token_id", req.GetTokenId(), "error", err)
+		return nil, err
+	}
+	if err := distinctParties(req.GetOwnerAddressId(), req.GetCreditorAddressId()); err != nil {
+		t.logger.Error("owner is the creditor", "method", "TransferToCreditor", "token_id", req.GetTokenId(), "error", err)
+		return nil, err
+	}
+
 	if t.features.Loan {
 		return t.transferToCreditorWithLoan(ctx, req)
 	}
@@ -252,6 +463,19 @@ func (t *Token) TransferToCreditor(ctx context.Context, req *tokenv1.TransferToC
 //
 // Returns the transfer response with transaction details.
 func (t *Token) BuyoutFromCreditor(ctx context.Context, req *tokenv1.BuyoutFromCreditorRequest) (*tokenv1.BuyoutFromCreditorResponse, error) {
+	if err := ValidateIssuanceID(req.GetTokenId()); err != nil {
+		t.logger.Error("invalid token id", "method", "BuyoutFromCreditor", "token_id", req.GetTokenId(), "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token id: %v", err)
+	}
+	if err := t.verifyRequestSignature("BuyoutFromCreditor", req.GetDocumentHash(), req.GetSignature()); err != nil {
+		t.logger.Error("request signature rejected", "method", "BuyoutFromCreditor", "token_id", req.GetTokenId(), "error", err)
+		return nil, err
+	}
+	if err := distinctParties(req.GetOwnerAddressId(), req.GetCreditorAddressId()); err != nil {
+		t.logger.Error("owner is the creditor", "method", "BuyoutFromCreditor", "token_id", req.GetTokenId(), "error", err)
+		return nil, err
+	}
+
 	if t.features.Loan {
 		return t.buyoutFromCreditorWithLoan(ctx, req)
 	}
@@ -272,17 +496,29 @@ func (t *Token) BuyoutFromCreditor(ctx context.Context, req *tokenv1.BuyoutFromC
 //
 // Returns the redemption response with transaction details.
 func (t *Token) TransferFromOwnerToWarehouse(ctx context.Context, req *tokenv1.TransferFromOwnerToWarehouseRequest) (*tokenv1.TransferFromOwnerToWarehouseResponse, error) {
-	l := t.logger.With("method", "TransferFromOwnerToWarehouse",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "TransferFromOwnerToWarehouse", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"owner_address_id", req.GetOwnerAddressId(),
 		"token_id", req.GetTokenId(),
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := ValidateIssuanceID(req.GetTokenId()); err != nil {
+		l.Error("invalid token id", "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token id: %v", err)
+	}
+	if err := t.verifyRequestSignature("TransferFromOwnerToWarehouse", req.GetDocumentHash(), req.GetSignature()); err != nil {
+		l.Error("request signature rejected", "error", err)
+		return nil, err
+	}
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	ownerSeeds := strings.Split(req.GetOwnerAddressPass(), "-")
-	owner, err := crypto.NewWalletFromHexSeed(ownerSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", ownerSeeds[1]))
+	owner, err := t.bc.WalletFromPass(req.GetOwnerAddressPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -298,10 +534,10 @@ func (t *Token) TransferFromOwnerToWarehouse(ctx context.Context, req *tokenv1.T
 		return nil, status.Errorf(codes.Internal, "failed to get issuer address: %v", err)
 	}
 
-	hash, err := t.bc.TransferMPToken(owner, req.GetTokenId(), issuerAddr)
+	hash, err := t.bc.TransferMPToken(owner, req.GetTokenId(), issuerAddr, t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	return &tokenv1.TransferFromOwnerToWarehouseResponse{
@@ -310,7 +546,7 @@ func (t *Token) TransferFromOwnerToWarehouse(ctx context.Context, req *tokenv1.T
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
 			},
 		},
@@ -330,6 +566,15 @@ func (t *Token) TransferFromOwnerToWarehouse(ctx context.Context, req *tokenv1.T
 //
 // Returns the redemption response with transaction details.
 func (t *Token) TransferFromCreditorToWarehouse(ctx context.Context, req *tokenv1.TransferFromCreditorToWarehouseRequest) (*tokenv1.TransferFromCreditorToWarehouseResponse, error) {
+	if err := ValidateIssuanceID(req.GetTokenId()); err != nil {
+		t.logger.Error("invalid token id", "method", "TransferFromCreditorToWarehouse", "token_id", req.GetTokenId(), "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token id: %v", err)
+	}
+	if err := t.verifyRequestSignature("TransferFromCreditorToWarehouse", req.GetDocumentHash(), req.GetSignature()); err != nil {
+		t.logger.Error("request signature rejected", "method", "TransferFromCreditorToWarehouse", "token_id", req.GetTokenId(), "error", err)
+		return nil, err
+	}
+
 	if t.features.Loan {
 		return t.transferFromCreditorToWarehouseWithLoan(ctx, req)
 	}
@@ -400,8 +645,12 @@ func (t *Token) RevertReplacement(ctx context.Context, req *tokenv1.RevertReplac
 // - req.TransactionId: The transaction hash to query
 //
 // Returns detailed transaction information including status, fees, and confirmation details.
+// The transaction is reported successful only once it meets the configured confirmation
+// depth. Beyond the default depth, it is also reported fully confirmed only once it meets it.
+// Each ledger object the transaction created, modified or deleted is listed as an
+// "AffectedObject" event with node_type, ledger_entry_type and ledger_index values.
 func (t *Token) TransactionInfo(ctx context.Context, req *tokenv1.TransactionInfoRequest) (*tokenv1.TransactionInfoResponse, error) {
-	l := t.logger.With("method", "TransactionInfo",
+	l := t.logger.With("method", "TransactionInfo", "correlation_id", CorrelationID(ctx),
 		"transaction_hash", req.GetTransactionId())
 	l.Debug("start")
 
@@ -411,27 +660,36 @@ func (t *Token) TransactionInfo(ctx context.Context, req *tokenv1.TransactionInf
 		return nil, status.Errorf(codes.Internal, "failed to get transaction info: %v", err)
 	}
 
-	fee, err := strconv.ParseUint(fmt.Sprintf("%d", baseTx.Fee), 10, 64)
+	fee, err := FeeFromAny(baseTx.Fee)
 	if err != nil {
 		l.Error("failed to convert fee to uint64", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to convert fee to uint64: %v", err)
 	}
+	final, err := t.bc.IsFinal(resp)
+	if err != nil {
+		l.Error("failed to check confirmation depth", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to check confirmation depth: %v", err)
+	}
+	fullyConfirmed := strings.Contains(meta.TransactionResult, "SUCCESS")
+	if t.bc.depth() > DefaultConfirmationDepth {
+		fullyConfirmed = fullyConfirmed && final
+	}
 
 	return &tokenv1.TransactionInfoResponse{
 		Error: nil,
 		Transaction: &typesv1.Transaction{
 			Id:             req.GetTransactionId(),
 			BlockNumber:    []byte(fmt.Sprintf("%d", resp.LedgerIndex)),
-			BlockTime:      uint64(resp.Date),
-			FullyConfirmed: strings.Contains(meta.TransactionResult, "SUCCESS"),
+			BlockTime:      RippleTimeToUnix(uint32(resp.Date)),
+			FullyConfirmed: fullyConfirmed,
 			GasUsed:        fee,
 			GasPrice:       1,
 			Method:         string(baseTx.TransactionType),
-			Input:          fmt.Sprintf("%d", baseTx.Fee),
-			Events:         nil,
+			Input:          strconv.FormatUint(fee, 10),
+			Events:         affectedObjectEvents(GetAffectedObjects(&meta)),
 			// backend use next values to define if transaction is completed
 			BlockCount: 1000,
-			IsSuccess:  resp.Validated,
+			IsSuccess:  final,
 		},
 	}, nil
 }
@@ -2,14 +2,16 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Peersyst/xrpl-go/xrpl/wallet"
 	"github.com/shopspring/decimal"
-	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
 	tokenv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/token/v1"
 	typesv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/types/v1"
 	"google.golang.org/grpc/codes"
@@ -20,11 +22,20 @@ type Loan struct {
 	Principal          decimal.Decimal
 	AnnualInterestRate decimal.Decimal
 	Period             time.Duration
+	Term               time.Duration
 	NextPaymentDate    time.Time
 	OwnerWallet        *wallet.Wallet
 	CreditorWallet     *wallet.Wallet
-	Currency           string
-	DebtTokenID        string
+	// CreditorSigners sign for a multi-sig creditor; when set, tokens are returned from
+	// the creditor account with a multi-signed transfer instead of CreditorWallet's key.
+	CreditorSigners []*wallet.Wallet
+	Currency        string
+	DebtTokenID     string
+	// Pending marks a loan whose setup has not completed: it accrues no interest
+	// and can only be cancelled.
+	Pending bool
+	// PrincipalLent is set once the creditor has paid the principal to the owner.
+	PrincipalLent bool
 	// LoanEndDate         time.Time
 }
 
@@ -33,6 +44,7 @@ func NewLoan(ownerWallet *wallet.Wallet, creditorWallet *wallet.Wallet) Loan {
 		Principal:          decimal.NewFromInt(LoanAmount),
 		AnnualInterestRate: decimal.NewFromFloat(LoanInterestRate),
 		Period:             LoanPeriod,
+		Term:               LoanTerm,
 		NextPaymentDate:    time.Now().Add(LoanPeriod),
 		OwnerWallet:        ownerWallet,
 		CreditorWallet:     creditorWallet,
@@ -45,13 +57,24 @@ func (l *Loan) SetDebtTokenID(debtTokenID string) {
 }
 
 type Loans struct {
+	// mu guards loans, which handlers change while the accrual loop iterates it. Changes
+	// that depend on the ledger are also made under the blockchain lock.
+	mu     sync.Mutex
 	loans  map[string]Loan
 	bc     *Blockchain
 	logger *slog.Logger
+
+	// paymentTimeout bounds the network calls of a single interest payment, zero means no bound.
+	paymentTimeout time.Duration
 }
 
-func NewLoans(logger *slog.Logger, bc *Blockchain) *Loans {
-	l := &Loans{loans: make(map[string]Loan), logger: logger.With("method", "Loans"), bc: bc}
+func NewLoans(logger *slog.Logger, bc *Blockchain, paymentTimeout time.Duration) *Loans {
+	l := &Loans{
+		loans:          make(map[string]Loan),
+		logger:         logger.With("method", "Loans"),
+		bc:             bc,
+		paymentTimeout: paymentTimeout,
+	}
 	go l.processLoans()
 	l.logger.Debug("loans initialized and started processing")
 
@@ -59,11 +82,47 @@ func NewLoans(logger *slog.Logger, bc *Blockchain) *Loans {
 }
 
 func (l *Loans) AddLoan(tokenID string, loan Loan) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 	l.loans[tokenID] = loan
 }
 
+// creditorSigners returns the wallets signing for a creditor configured as multi-sig.
+//
+// Parameters:
+// - creditor: The address of the creditor account
+//
+// Returns the signer wallets, none if the creditor signs alone, or an error if a
+// configured pass is malformed.
+func (t *Token) creditorSigners(creditor string) ([]*wallet.Wallet, error) {
+	var signers []*wallet.Wallet
+	for _, configured := range t.features.CreditorSigners {
+		if configured.Address != creditor {
+			continue
+		}
+		for _, pass := range configured.Passes {
+			signer, err := t.bc.WalletFromPass(pass)
+			if err != nil {
+				return nil, fmt.Errorf("signer of creditor %s: %w", creditor, err)
+			}
+			signers = append(signers, signer)
+		}
+	}
+	return signers, nil
+}
+
+// transferFromCreditor transfers a token from the creditor of the loan, multi-signed
+// when the creditor is configured with signers.
+func (l Loan) transferFromCreditor(bc *Blockchain, creditor *wallet.Wallet, issuanceID, to string,
+	opts ...TransferOption) (string, error) {
+	if len(l.CreditorSigners) > 0 {
+		return bc.TransferMPTokenMultisig(creditor.ClassicAddress.String(), l.CreditorSigners, issuanceID, to, opts...)
+	}
+	return bc.TransferMPToken(creditor, issuanceID, to, opts...)
+}
+
 func (l *Loans) GetLoan(tokenID string) (Loan, error) {
-	loan, ok := l.loans[tokenID]
+	loan, ok := l.trackedLoan(tokenID)
 	if !ok {
 		return Loan{}, fmt.Errorf("loan not found")
 	}
@@ -71,65 +130,325 @@ func (l *Loans) GetLoan(tokenID string) (Loan, error) {
 }
 
 func (l *Loans) RemoveLoan(tokenID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
 	delete(l.loans, tokenID)
 }
 
+// trackedLoan returns the loan of the token and whether it is tracked.
+func (l *Loans) trackedLoan(tokenID string) (Loan, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	loan, ok := l.loans[tokenID]
+	return loan, ok
+}
+
+// updateLoan applies the change to the loan of the token if it is still tracked,
+// so that a loan removed meanwhile is not tracked again.
+func (l *Loans) updateLoan(tokenID string, change func(*Loan)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if loan, ok := l.loans[tokenID]; ok {
+		change(&loan)
+		l.loans[tokenID] = loan
+	}
+}
+
+// Cancel aborts a loan, pending or set up, and unwinds it under the blockchain lock:
+// the debt token is returned to the owner and burnt, the warrant token is returned to
+// the owner, and the principal the creditor lent is repaid. The RLUSD the system account
+// provided for the loan stays with the parties.
+//
+// Each step is skipped once done, judged by the ledger for tokens and by the loan for the
+// principal, and the loan is updated after each step, so a cancellation that failed part
+// way can be retried. The loan is removed from tracking once unwound; cancelling a loan
+// that is not tracked does nothing.
+//
+// Parameters:
+// - tokenID: The warrant token ID of the loan
+//
+// Returns an error if a step fails, leaving the loan tracked with the steps done so far.
+func (l *Loans) Cancel(tokenID string) error {
+	l.bc.Lock()
+	defer l.bc.Unlock()
+	l.bc.SetCorrelationID(tokenID)
+
+	loan, ok := l.trackedLoan(tokenID)
+	if !ok {
+		return nil
+	}
+	owner := loan.OwnerWallet.ClassicAddress.String()
+	creditor := loan.CreditorWallet
+
+	if loan.DebtTokenID != "" {
+		held, err := l.bc.GetMPTokenBalance(creditor.ClassicAddress.String(), loan.DebtTokenID)
+		if err != nil {
+			return fmt.Errorf("failed to get debt token balance: %w", err)
+		}
+		if held > 0 {
+			if _, err := loan.transferFromCreditor(l.bc, creditor, loan.DebtTokenID, owner); err != nil {
+				return fmt.Errorf("failed to return debt token: %w", err)
+			}
+		}
+
+		_, err = l.bc.GetIssuanceOutstanding(loan.DebtTokenID)
+		switch {
+		case errors.Is(err, ErrMPTIssuanceNotFound):
+		case err != nil:
+			return err
+		default:
+			if err := l.bc.MPTokenIssuanceDestroy(loan.OwnerWallet, loan.DebtTokenID); err != nil {
+				return fmt.Errorf("failed to destroy debt token: %w", err)
+			}
+		}
+		loan.DebtTokenID = ""
+		l.updateLoan(tokenID, func(tracked *Loan) { tracked.DebtTokenID = "" })
+	}
+
+	held, err := l.bc.GetMPTokenBalance(creditor.ClassicAddress.String(), tokenID)
+	if err != nil {
+		return fmt.Errorf("failed to get warrant token balance: %w", err)
+	}
+	if held > 0 {
+		if _, err := loan.transferFromCreditor(l.bc, creditor, tokenID, owner); err != nil {
+			return fmt.Errorf("failed to return warrant token: %w", err)
+		}
+	}
+
+	if loan.PrincipalLent {
+		if _, err := l.bc.PaymentRLUSD(loan.OwnerWallet, creditor, loan.Principal.InexactFloat64()); err != nil {
+			return fmt.Errorf("failed to repay principal: %w", err)
+		}
+		loan.PrincipalLent = false
+		l.updateLoan(tokenID, func(tracked *Loan) { tracked.PrincipalLent = false })
+	}
+
+	l.RemoveLoan(tokenID)
+	l.logger.Debug("cancelled loan", "token_id", tokenID)
+	return nil
+}
+
 func (l *Loans) processLoans() {
 	for {
 		l.logger.Debug("processing loans")
-		for tokenID, loan := range l.loans {
-			if loan.NextPaymentDate.Before(time.Now()) {
-				loan.NextPaymentDate = loan.NextPaymentDate.Add(loan.Period)
-				l.loans[tokenID] = loan
-
-				l.logger.Debug("processing loan",
-					"token_id", tokenID,
-					"next_payment_date", loan.NextPaymentDate,
-					"principal", loan.Principal,
-					"annual_interest_rate", loan.AnnualInterestRate,
-					"period", loan.Period,
-					"owner_wallet", loan.OwnerWallet.ClassicAddress.String(),
-					"creditor_wallet", loan.CreditorWallet.ClassicAddress.String(),
-					"currency", loan.Currency,
-				)
-				err := l.processLoan(tokenID, loan)
-				if err != nil {
-					l.logger.Error("failed to process loan", "error", err)
-				}
-			}
-		}
+		l.processDueLoans()
 		time.Sleep(time.Minute)
 	}
 }
 
-func (l *Loans) processLoan(tokenID string, loan Loan) error {
-	l.bc.Lock()
+// processDueLoans pays the interest of every loan whose payment date has passed.
+// The loans of a payer are paid together in Batch transactions of up to the configured
+// batch size of payments, so a tick sends one transaction per payer rather than one per loan.
+// A failed or timed out payment keeps its date, so the next tick retries it.
+func (l *Loans) processDueLoans() {
+	now := time.Now()
+	for _, tokenIDs := range l.dueLoans(now) {
+		slices.Sort(tokenIDs)
+		for chunk := range slices.Chunk(tokenIDs, l.bc.batchSize()) {
+			l.payDueLoans(chunk, now)
+		}
+	}
+}
+
+// dueLoans returns the token IDs of the loans whose payment date has passed by payer.
+func (l *Loans) dueLoans(now time.Time) map[string][]string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	due := make(map[string][]string)
+	for tokenID, loan := range l.loans {
+		if !loan.Pending && loan.NextPaymentDate.Before(now) {
+			l.logger.Debug("processing loan",
+				"token_id", tokenID,
+				"next_payment_date", loan.NextPaymentDate,
+				"principal", loan.Principal,
+				"annual_interest_rate", loan.AnnualInterestRate,
+				"period", loan.Period,
+				"owner_wallet", loan.OwnerWallet.ClassicAddress.String(),
+				"creditor_wallet", loan.CreditorWallet.ClassicAddress.String(),
+				"currency", loan.Currency,
+			)
+			payer := loan.OwnerWallet.ClassicAddress.String()
+			due[payer] = append(due[payer], tokenID)
+		}
+	}
+	return due
+}
+
+// payDueLoans pays the interest of loans of the same payer. A single loan is paid with
+// a plain payment, several with a Batch.
+func (l *Loans) payDueLoans(tokenIDs []string, now time.Time) {
+	var err error
+	if len(tokenIDs) == 1 {
+		err = l.processLoan(tokenIDs[0], now)
+	} else {
+		err = l.processLoanBatch(tokenIDs, now)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		l.logger.Warn("loan payment timed out, retrying next tick",
+			"token_ids", tokenIDs, "timeout", l.paymentTimeout)
+		return
+	}
+	if err != nil {
+		// Keep the payment date so the next tick pays the whole elapsed time.
+		l.logger.Error("failed to process loan", "token_ids", tokenIDs, "error", err)
+	}
+}
+
+// advancePaymentDate moves the payment date of a loan one period past now, once its
+// interest up to now is paid. A loan no longer tracked is left untracked.
+func (l *Loans) advancePaymentDate(tokenID string, now time.Time) {
+	l.updateLoan(tokenID, func(loan *Loan) { loan.NextPaymentDate = now.Add(loan.Period) })
+}
+
+// AccruedInterest returns the interest accrued since the last payment, which is
+// assumed to be one period before NextPaymentDate. Interest is computed over the
+// actual elapsed time, so a late tick or downtime is paid in full on catch-up.
+//
+// Parameters:
+// - now: The moment up to which interest is accrued
+//
+// Returns the accrued interest, or zero if no time has elapsed.
+func (l Loan) AccruedInterest(now time.Time) decimal.Decimal {
+	elapsed := now.Sub(l.NextPaymentDate.Add(-l.Period))
+	if elapsed <= 0 {
+		return decimal.Zero
+	}
+
+	return l.interestOver(elapsed)
+}
+
+// interestOver returns the simple interest the principal accrues over the given time.
+func (l Loan) interestOver(elapsed time.Duration) decimal.Decimal {
+	yearlyInterest := l.Principal.Mul(l.AnnualInterestRate).Div(decimal.NewFromInt(100))
+	year := decimal.NewFromInt(int64(365 * 24 * time.Hour))
+	return yearlyInterest.Mul(decimal.NewFromInt(int64(elapsed))).Div(year)
+}
+
+// paymentContext returns the context bounding the network calls of a payment by paymentTimeout.
+func (l *Loans) paymentContext() (context.Context, context.CancelFunc) {
+	if l.paymentTimeout > 0 {
+		return context.WithTimeout(context.Background(), l.paymentTimeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// processLoan pays the interest a loan accrued up to now and moves its payment date.
+// The loan is read under the blockchain lock, so a loan bought out or cancelled while
+// the payment waited for the lock is skipped.
+func (l *Loans) processLoan(tokenID string, now time.Time) error {
+	ctx, cancel := l.paymentContext()
+	defer cancel()
+
+	if err := l.bc.TryLock(ctx); err != nil {
+		return err
+	}
 	defer l.bc.Unlock()
+	l.bc.SetCorrelationID(tokenID)
 
-	dailyRate := loan.AnnualInterestRate.Div(decimal.NewFromInt(100)).Div(decimal.NewFromInt(365))
-	interest := loan.Principal.Mul(dailyRate)
+	loan, ok := l.trackedLoan(tokenID)
+	if !ok {
+		return nil
+	}
+	interest := loan.AccruedInterest(now)
+	if !interest.IsPositive() {
+		l.advancePaymentDate(tokenID, now)
+		return nil
+	}
 
-	err := l.bc.PaymentRLUSD(loan.OwnerWallet, loan.CreditorWallet, interest.InexactFloat64())
+	// Abort the payment once the deadline passes so the lock is released,
+	// binding only the requests of the payment itself
+	l.bc.SetContext(ctx)
+	hash, err := l.bc.PaymentRLUSD(loan.OwnerWallet, loan.CreditorWallet, interest.InexactFloat64())
+	l.bc.SetContext(nil)
 	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("failed to payment RLUSD: %w", ctx.Err())
+		}
 		return fmt.Errorf("failed to payment RLUSD: %v", err)
 	}
-	l.logger.Debug("processed loan", "token_id", tokenID)
+	l.logger.Debug("processed loan", "token_id", tokenID, "hash", hash)
+	l.advancePaymentDate(tokenID, now)
+	return nil
+}
+
+// processLoanBatch pays the interest of several loans of the same payer in a single Batch,
+// and moves their payment dates. When a single loan has interest due, it is paid with a plain payment, as
+// a Batch needs at least MinBatchSize inner transactions. Like processLoan, the loans are
+// read under the blockchain lock and those no longer tracked are skipped.
+func (l *Loans) processLoanBatch(tokenIDs []string, now time.Time) error {
+	ctx, cancel := l.paymentContext()
+	defer cancel()
+
+	if err := l.bc.TryLock(ctx); err != nil {
+		return err
+	}
+	defer l.bc.Unlock()
+	l.bc.SetCorrelationID(strings.Join(tokenIDs, ","))
+
+	var payer *wallet.Wallet
+	payments := make([]RLUSDPayment, 0, len(tokenIDs))
+	paid := make([]string, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		loan, ok := l.trackedLoan(tokenID)
+		if !ok {
+			continue
+		}
+		interest := loan.AccruedInterest(now)
+		if !interest.IsPositive() {
+			l.advancePaymentDate(tokenID, now)
+			continue
+		}
+		payer = loan.OwnerWallet
+		payments = append(payments, RLUSDPayment{To: loan.CreditorWallet, Amount: interest.InexactFloat64()})
+		paid = append(paid, tokenID)
+	}
+	if len(payments) == 0 {
+		return nil
+	}
+
+	// Abort the payment once the deadline passes so the lock is released,
+	// binding only the requests of the payment itself
+	l.bc.SetContext(ctx)
+	var hash string
+	var err error
+	if len(payments) == 1 {
+		hash, err = l.bc.PaymentRLUSD(payer, payments[0].To, payments[0].Amount)
+	} else {
+		hash, err = l.bc.PaymentRLUSDBatch(payer, payments)
+	}
+	l.bc.SetContext(nil)
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("failed to payment RLUSD batch: %w", ctx.Err())
+		}
+		return fmt.Errorf("failed to payment RLUSD batch: %v", err)
+	}
+	l.logger.Debug("processed loans", "token_ids", tokenIDs, "hash", hash)
+
+	for _, tokenID := range paid {
+		l.advancePaymentDate(tokenID, now)
+	}
 	return nil
 }
 
 func (t *Token) transferToCreditor(ctx context.Context, req *tokenv1.TransferToCreditorRequest) (*tokenv1.TransferToCreditorResponse, error) {
-	l := t.logger.With("method", "TransferToCreditor",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "TransferToCreditor", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"creditor_address_id", req.GetCreditorAddressId(),
 		"owner_address_id", req.GetOwnerAddressId(),
 		"token_id", req.GetTokenId(),
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	creditorSeeds := strings.Split(req.GetCreditorPass(), "-")
-	creditor, err := crypto.NewWalletFromHexSeed(creditorSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", creditorSeeds[1]))
+	creditor, err := t.bc.WalletFromPass(req.GetCreditorPass())
 	if err != nil {
 		t.logger.Error("failed to create recipient wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create recipient wallet: %v", err)
@@ -139,8 +458,7 @@ func (t *Token) transferToCreditor(ctx context.Context, req *tokenv1.TransferToC
 		return nil, status.Errorf(codes.InvalidArgument, "creditor address does not match")
 	}
 
-	ownerSeeds := strings.Split(req.GetOwnerAddressPass(), "-")
-	owner, err := crypto.NewWalletFromHexSeed(ownerSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", ownerSeeds[1]))
+	owner, err := t.bc.WalletFromPass(req.GetOwnerAddressPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -153,15 +471,16 @@ func (t *Token) transferToCreditor(ctx context.Context, req *tokenv1.TransferToC
 	l.Debug("authorizing token")
 	err = t.bc.AuthorizeMPToken(creditor, req.GetTokenId())
 	if err != nil {
-		l.Warn("failed to authorize token", "error", err)
+		l.Error("failed to authorize token", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to authorize token: %v", err)
 	}
 	l.Debug("authorized token")
 
 	l.Debug("transferring token to creditor")
-	hash, err := t.bc.TransferMPToken(owner, req.GetTokenId(), creditor.ClassicAddress.String())
+	hash, err := t.bc.TransferMPToken(owner, req.GetTokenId(), creditor.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	return &tokenv1.TransferToCreditorResponse{
@@ -170,7 +489,7 @@ func (t *Token) transferToCreditor(ctx context.Context, req *tokenv1.TransferToC
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
 			},
 		},
@@ -179,18 +498,22 @@ func (t *Token) transferToCreditor(ctx context.Context, req *tokenv1.TransferToC
 
 func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.TransferToCreditorRequest) (*tokenv1.TransferToCreditorResponse, error) {
 	tokenID := req.GetTokenId()
-	l := t.logger.With("method", "TransferToCreditorWithLoan",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "TransferToCreditorWithLoan", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"creditor_address_id", req.GetCreditorAddressId(),
 		"owner_address_id", req.GetOwnerAddressId(),
 		"token_id", tokenID,
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	creditorSeeds := strings.Split(req.GetCreditorPass(), "-")
-	creditor, err := crypto.NewWalletFromHexSeed(creditorSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", creditorSeeds[1]))
+	creditor, err := t.bc.WalletFromPass(req.GetCreditorPass())
 	if err != nil {
 		t.logger.Error("failed to create recipient wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create recipient wallet: %v", err)
@@ -200,8 +523,7 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 		return nil, status.Errorf(codes.InvalidArgument, "creditor address does not match")
 	}
 
-	ownerSeeds := strings.Split(req.GetOwnerAddressPass(), "-")
-	owner, err := crypto.NewWalletFromHexSeed(ownerSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", ownerSeeds[1]))
+	owner, err := t.bc.WalletFromPass(req.GetOwnerAddressPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -211,29 +533,53 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 		return nil, status.Errorf(codes.InvalidArgument, "owner address does not match")
 	}
 
-	l.Debug("setup initial balances for parties")
+	loan := NewLoan(owner, creditor)
+	loan.CreditorSigners, err = t.creditorSigners(creditor.ClassicAddress.String())
+	if err != nil {
+		l.Error("failed to create creditor signer wallets", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create creditor signer wallets: %v", err)
+	}
+
+	l.Debug("estimating loan lifecycle cost")
+	cost, err := t.loans.EstimateLifecycleCost(loan)
+	if err != nil {
+		l.Error("failed to estimate loan cost", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to estimate loan cost: %v", err)
+	}
+	err = t.bc.AssertSystemBalance(cost.XRP(), cost.RLUSD)
+	if errors.Is(err, ErrInsufficientSystemFunds) {
+		l.Error("system account cannot fund loan", "xrp_drops", cost.XRP(), "rlusd", cost.RLUSD, "error", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "system account cannot fund loan: %v", err)
+	}
+	if err != nil {
+		l.Error("failed to check system account balance", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to check system account balance: %v", err)
+	}
+
+	l.Debug("setup initial balances for parties", "rlusd", cost.RLUSD, "interest", cost.Interest)
 	err = t.bc.SystemAccountInit()
 	if err != nil {
 		l.Error("failed to initialize system account", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to initialize system account: %v", err)
 	}
 
-	loan := NewLoan(owner, creditor)
-
-	err = t.bc.CreateTrustlineFromSystemAccount(owner, loan.Principal.InexactFloat64()*10)
+	// Each party holds at most the principal plus the interest paid over the term
+	sent, err := t.bc.CreateTrustlineFromSystemAccount(owner, cost.RLUSD.InexactFloat64())
 	if err != nil {
 		l.Error("failed to create trustline", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to create trustline: %v", err)
 	}
+	l.Debug("owner/borrower trustline ready", "submitted", sent)
 
-	err = t.bc.CreateTrustlineFromSystemAccount(creditor, loan.Principal.InexactFloat64()*10)
+	sent, err = t.bc.CreateTrustlineFromSystemAccount(creditor, cost.RLUSD.InexactFloat64())
 	if err != nil {
 		l.Error("failed to create trustline", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to create trustline: %v", err)
 	}
+	l.Debug("creditor/lender trustline ready", "submitted", sent)
 
 	l.Debug("repelling RLUSD (sum of loan interest) from System Account to owner/borrower")
-	err = t.bc.PaymentRLUSDFromSystemAccount(owner, loan.Principal.InexactFloat64()/10)
+	_, err = t.bc.PaymentRLUSDFromSystemAccount(owner, cost.Interest.InexactFloat64())
 	if err != nil {
 		// l.Warn("failed to payment RLUSD from system account", "error", err)
 		l.Error("failed to payment RLUSD from system account", "error", err)
@@ -241,7 +587,7 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 	}
 
 	l.Debug("repelling RLUSD (loan body) from System Account to creditor/lender")
-	err = t.bc.PaymentRLUSDFromSystemAccount(creditor, loan.Principal.InexactFloat64())
+	_, err = t.bc.PaymentRLUSDFromSystemAccount(creditor, loan.Principal.InexactFloat64())
 	if err != nil {
 		// l.Warn("failed to payment RLUSD from system account", "error", err)
 		l.Error("failed to payment RLUSD from system account", "error", err)
@@ -251,36 +597,45 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 	l.Debug("minting debt token")
 	debtToken := NewDebtMPToken(tokenID, owner.ClassicAddress.String(), creditor.ClassicAddress.String())
 	hash, issuanceID, err := t.bc.MPTokenIssuanceCreate(owner, debtToken)
+	if errors.Is(err, ErrInvalidIssuanceID) || errors.Is(err, ErrInvalidIssuer) || errors.Is(err, ErrInvalidCreditor) {
+		l.Error("invalid debt token", "error", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid debt token: %v", err)
+	}
 	if err != nil {
 		l.Error("failed to mint debt token", "hash", hash, "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to mint debt token: %v", err)
 	}
 	loan.SetDebtTokenID(issuanceID)
+	// Track the loan from here so that a setup failing part way can be cancelled
+	loan.Pending = true
+	t.loans.AddLoan(tokenID, loan)
 
 	l = l.With("debt_token_id", issuanceID)
 	l.Debug("creditor/lender authorizing debt token")
 	err = t.bc.AuthorizeMPToken(creditor, issuanceID)
 	if err != nil {
-		l.Warn("failed to authorize debt token", "error", err)
+		l.Error("failed to authorize debt token", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to authorize debt token: %v", err)
 	}
 
 	l.Debug("transferring debt token to creditor")
-	hash, err = t.bc.TransferMPToken(owner, issuanceID, creditor.ClassicAddress.String())
+	hash, err = t.bc.TransferMPToken(owner, issuanceID, creditor.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer debt token", "hash", hash, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer debt token: %v", err)
+		return nil, transferStatus("failed to transfer debt token", err)
 	}
 
 	l.Debug("transferring warrant token to creditor")
 	err = t.bc.AuthorizeMPToken(creditor, tokenID)
 	if err != nil {
-		l.Warn("failed to authorize warrant token", "error", err)
+		l.Error("failed to authorize warrant token", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to authorize warrant token: %v", err)
 	}
 
-	mptHash, err := t.bc.TransferMPToken(owner, tokenID, creditor.ClassicAddress.String())
+	mptHash, err := t.bc.TransferMPToken(owner, tokenID, creditor.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "hash", hash, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	l.Debug("creditor/lender sending payment of RLUSD to owner/borrower with loan term",
@@ -289,7 +644,7 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 		"period", LoanPeriod,
 	)
 
-	err = t.bc.PaymentRLUSD(creditor, owner, loan.Principal.InexactFloat64())
+	_, err = t.bc.PaymentRLUSD(creditor, owner, loan.Principal.InexactFloat64())
 	if err != nil {
 		// l.Warn("failed to payment RLUSD", "error", err)
 		l.Error("failed to payment RLUSD", "error", err)
@@ -297,6 +652,8 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 	}
 
 	l.Debug("add loan to interests tracking")
+	loan.Pending = false
+	loan.PrincipalLent = true
 	t.loans.AddLoan(tokenID, loan)
 
 	return &tokenv1.TransferToCreditorResponse{
@@ -305,7 +662,7 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        mptHash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(mptHash),
 				IsSuccess: true,
 			},
 		},
@@ -313,18 +670,22 @@ func (t *Token) transferToCreditorWithLoan(ctx context.Context, req *tokenv1.Tra
 }
 
 func (t *Token) buyoutFromCreditor(ctx context.Context, req *tokenv1.BuyoutFromCreditorRequest) (*tokenv1.BuyoutFromCreditorResponse, error) {
-	l := t.logger.With("method", "BuyoutFromCreditor",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "BuyoutFromCreditor", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"creditor_address_id", req.GetCreditorAddressId(),
 		"owner_address_id", req.GetOwnerAddressId(),
 		"token_id", req.GetTokenId(),
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	creditorSeeds := strings.Split(req.GetCreditorAddressPass(), "-")
-	creditor, err := crypto.NewWalletFromHexSeed(creditorSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", creditorSeeds[1]))
+	creditor, err := t.bc.WalletFromPass(req.GetCreditorAddressPass())
 	if err != nil {
 		t.logger.Error("failed to create recipient wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create recipient wallet: %v", err)
@@ -334,8 +695,7 @@ func (t *Token) buyoutFromCreditor(ctx context.Context, req *tokenv1.BuyoutFromC
 		return nil, status.Errorf(codes.InvalidArgument, "creditor address does not match")
 	}
 
-	ownerSeeds := strings.Split(req.GetOwnerPass(), "-")
-	owner, err := crypto.NewWalletFromHexSeed(ownerSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", ownerSeeds[1]))
+	owner, err := t.bc.WalletFromPass(req.GetOwnerPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -347,13 +707,14 @@ func (t *Token) buyoutFromCreditor(ctx context.Context, req *tokenv1.BuyoutFromC
 
 	err = t.bc.AuthorizeMPToken(owner, req.GetTokenId())
 	if err != nil {
-		l.Warn("failed to authorize token", "error", err)
+		l.Error("failed to authorize token", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to authorize token: %v", err)
 	}
 
-	hash, err := t.bc.TransferMPToken(creditor, req.GetTokenId(), owner.ClassicAddress.String())
+	hash, err := t.bc.TransferMPToken(creditor, req.GetTokenId(), owner.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	return &tokenv1.BuyoutFromCreditorResponse{
@@ -362,7 +723,7 @@ func (t *Token) buyoutFromCreditor(ctx context.Context, req *tokenv1.BuyoutFromC
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
 			},
 		},
@@ -371,18 +732,22 @@ func (t *Token) buyoutFromCreditor(ctx context.Context, req *tokenv1.BuyoutFromC
 
 func (t *Token) buyoutFromCreditorWithLoan(ctx context.Context, req *tokenv1.BuyoutFromCreditorRequest) (*tokenv1.BuyoutFromCreditorResponse, error) {
 	tokenID := req.GetTokenId()
-	l := t.logger.With("method", "BuyoutFromCreditorWithLoan",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "BuyoutFromCreditorWithLoan", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"creditor_address_id", req.GetCreditorAddressId(),
 		"owner_address_id", req.GetOwnerAddressId(),
 		"token_id", tokenID,
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	creditorSeeds := strings.Split(req.GetCreditorAddressPass(), "-")
-	creditor, err := crypto.NewWalletFromHexSeed(creditorSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", creditorSeeds[1]))
+	creditor, err := t.bc.WalletFromPass(req.GetCreditorAddressPass())
 	if err != nil {
 		t.logger.Error("failed to create recipient wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create recipient wallet: %v", err)
@@ -392,8 +757,7 @@ func (t *Token) buyoutFromCreditorWithLoan(ctx context.Context, req *tokenv1.Buy
 		return nil, status.Errorf(codes.InvalidArgument, "creditor address does not match")
 	}
 
-	ownerSeeds := strings.Split(req.GetOwnerPass(), "-")
-	owner, err := crypto.NewWalletFromHexSeed(ownerSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", ownerSeeds[1]))
+	owner, err := t.bc.WalletFromPass(req.GetOwnerPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -409,30 +773,40 @@ func (t *Token) buyoutFromCreditorWithLoan(ctx context.Context, req *tokenv1.Buy
 		l.Error("failed to get loan", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to get loan: %v", err)
 	}
-	err = t.bc.PaymentRLUSD(owner, creditor, loan.Principal.InexactFloat64())
+	// The creditor's limit was set for the projected interest, which may have been exceeded
+	err = t.bc.EnsureTrustlineCapacity(creditor, RLUSDHex, t.bc.SystemWallet().ClassicAddress.String(), loan.Principal)
+	if err != nil {
+		l.Error("failed to ensure creditor trustline capacity", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to ensure creditor trustline capacity: %v", err)
+	}
+	_, err = t.bc.PaymentRLUSD(owner, creditor, loan.Principal.InexactFloat64())
 	if err != nil {
 		l.Error("failed to payment RLUSD", "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to payment RLUSD: %v", err)
 	}
 
 	l.Debug("returning and burning debt token to owner/borrower")
-	hash, err := t.bc.TransferMPToken(creditor, loan.DebtTokenID, owner.ClassicAddress.String())
+	hash, err := loan.transferFromCreditor(t.bc, creditor, loan.DebtTokenID, owner.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "debt_token_id", loan.DebtTokenID, "hash", hash, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 	t.loans.RemoveLoan(tokenID)
 	err = t.bc.MPTokenIssuanceDestroy(owner, loan.DebtTokenID)
+	if errors.Is(err, ErrIssuanceHasHolders) {
+		l.Error("debt token not returned", "debt_token_id", loan.DebtTokenID, "error", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "debt token not returned: %v", err)
+	}
 	if err != nil {
 		l.Error("failed to destroy debt token", "debt_token_id", loan.DebtTokenID, "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to destroy debt token: %v", err)
 	}
 
 	l.Debug("returning warrant token to owner/borrower")
-	hash, err = t.bc.TransferMPToken(creditor, tokenID, owner.ClassicAddress.String())
+	hash, err = loan.transferFromCreditor(t.bc, creditor, tokenID, owner.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "hash", hash, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	return &tokenv1.BuyoutFromCreditorResponse{
@@ -441,7 +815,7 @@ func (t *Token) buyoutFromCreditorWithLoan(ctx context.Context, req *tokenv1.Buy
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
 			},
 		},
@@ -449,17 +823,21 @@ func (t *Token) buyoutFromCreditorWithLoan(ctx context.Context, req *tokenv1.Buy
 }
 
 func (t *Token) transferFromCreditorToWarehouse(ctx context.Context, req *tokenv1.TransferFromCreditorToWarehouseRequest) (*tokenv1.TransferFromCreditorToWarehouseResponse, error) {
-	l := t.logger.With("method", "TransferFromOwnerToWarehouse",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "TransferFromOwnerToWarehouse", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"creditor_address_id", req.GetCreditorAddressId(),
 		"token_id", req.GetTokenId(),
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	creditorSeeds := strings.Split(req.GetCreditorAddressPass(), "-")
-	creditor, err := crypto.NewWalletFromHexSeed(creditorSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", creditorSeeds[1]))
+	creditor, err := t.bc.WalletFromPass(req.GetCreditorAddressPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -475,10 +853,10 @@ func (t *Token) transferFromCreditorToWarehouse(ctx context.Context, req *tokenv
 		return nil, status.Errorf(codes.Internal, "failed to get issuer address: %v", err)
 	}
 
-	hash, err := t.bc.TransferMPToken(creditor, req.GetTokenId(), issuerAddr)
+	hash, err := t.bc.TransferMPToken(creditor, req.GetTokenId(), issuerAddr, t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	return &tokenv1.TransferFromCreditorToWarehouseResponse{
@@ -487,7 +865,7 @@ func (t *Token) transferFromCreditorToWarehouse(ctx context.Context, req *tokenv
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
 			},
 		},
@@ -496,17 +874,21 @@ func (t *Token) transferFromCreditorToWarehouse(ctx context.Context, req *tokenv
 
 func (t *Token) transferFromCreditorToWarehouseWithLoan(ctx context.Context, req *tokenv1.TransferFromCreditorToWarehouseRequest) (*tokenv1.TransferFromCreditorToWarehouseResponse, error) {
 	tokenID := req.GetTokenId()
-	l := t.logger.With("method", "TransferFromOwnerToWarehouseWithLoan",
+	correlationID := CorrelationID(ctx)
+	l := t.logger.With("method", "TransferFromOwnerToWarehouseWithLoan", "correlation_id", correlationID,
 		"document_hash", req.GetDocumentHash(),
 		"creditor_address_id", req.GetCreditorAddressId(),
 		"token_id", tokenID,
 	)
 	l.Debug("start")
-	t.bc.Lock()
+	if err := t.bc.TryLock(ctx); err != nil {
+		l.Error("failed to acquire blockchain lock", "error", err)
+		return nil, lockStatus(err)
+	}
 	defer t.bc.Unlock()
+	t.bc.SetCorrelationID(correlationID)
 
-	creditorSeeds := strings.Split(req.GetCreditorAddressPass(), "-")
-	creditor, err := crypto.NewWalletFromHexSeed(creditorSeeds[0], fmt.Sprintf("m/44'/144'/0'/0/%s", creditorSeeds[1]))
+	creditor, err := t.bc.WalletFromPass(req.GetCreditorAddressPass())
 	if err != nil {
 		t.logger.Error("failed to create sender wallet", "error", err)
 		return nil, status.Errorf(codes.InvalidArgument, "failed to create sender wallet: %v", err)
@@ -523,14 +905,18 @@ func (t *Token) transferFromCreditorToWarehouseWithLoan(ctx context.Context, req
 		return nil, status.Errorf(codes.Internal, "failed to get loan: %v", err)
 	}
 
-	hash, err := t.bc.TransferMPToken(creditor, loan.DebtTokenID, loan.OwnerWallet.ClassicAddress.String())
+	hash, err := t.bc.TransferMPToken(creditor, loan.DebtTokenID, loan.OwnerWallet.ClassicAddress.String(), t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "debt_token_id", loan.DebtTokenID, "hash", hash, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 	t.loans.RemoveLoan(tokenID)
 
 	err = t.bc.MPTokenIssuanceDestroy(loan.OwnerWallet, loan.DebtTokenID)
+	if errors.Is(err, ErrIssuanceHasHolders) {
+		l.Error("debt token not returned", "debt_token_id", loan.DebtTokenID, "error", err)
+		return nil, status.Errorf(codes.FailedPrecondition, "debt token not returned: %v", err)
+	}
 	if err != nil {
 		l.Error("failed to destroy debt token", "debt_token_id", loan.DebtTokenID, "error", err)
 		return nil, status.Errorf(codes.Internal, "failed to destroy debt token: %v", err)
@@ -543,10 +929,10 @@ func (t *Token) transferFromCreditorToWarehouseWithLoan(ctx context.Context, req
 		return nil, status.Errorf(codes.Internal, "failed to get issuer address: %v", err)
 	}
 
-	hash, err = t.bc.TransferMPToken(creditor, tokenID, issuerAddr)
+	hash, err = t.bc.TransferMPToken(creditor, tokenID, issuerAddr, t.transferOptions()...)
 	if err != nil {
 		l.Error("failed to transfer token", "hash", hash, "error", err)
-		return nil, status.Errorf(codes.Internal, "failed to transfer token: %v", err)
+		return nil, transferStatus("failed to transfer token", err)
 	}
 
 	return &tokenv1.TransferFromCreditorToWarehouseResponse{
@@ -555,7 +941,7 @@ func (t *Token) transferFromCreditorToWarehouseWithLoan(ctx context.Context, req
 			Id: req.GetDocumentHash(),
 			Transaction: &typesv1.Transaction{
 				Id:        hash,
-				BlockTime: uint64(time.Now().Unix()),
+				BlockTime: t.blockTime(hash),
 				IsSuccess: true,
 			},
 		},
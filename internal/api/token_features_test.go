@@ -0,0 +1,383 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+func newTestLoans(t *testing.T, bc *Blockchain) *Loans {
+	t.Helper()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return &Loans{loans: make(map[string]Loan), bc: bc, logger: logger}
+}
+
+func newTestLoan(t *testing.T) Loan {
+	t.Helper()
+	owner, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	if err != nil {
+		t.Fatalf("failed to create owner wallet: %v", err)
+	}
+	creditor, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/2")
+	if err != nil {
+		t.Fatalf("failed to create creditor wallet: %v", err)
+	}
+	return NewLoan(owner, creditor)
+}
+
+func TestLoans_ProcessLoan_PaysInterest(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+
+	// One full day elapsed since the last payment
+	now := time.Now()
+	loan := newTestLoan(t)
+	loan.NextPaymentDate = now.Add(-24 * time.Hour).Add(loan.Period)
+
+	tokenIDs := []string{"token-1", "token-2"}
+	for i, tokenID := range tokenIDs {
+		loans.AddLoan(tokenID, loan)
+		assert.NoError(t, loans.processLoan(tokenID, now))
+
+		tx := submittedTx(t, node, i)
+		assert.Equal(t, "Payment", tx["TransactionType"])
+		assert.Equal(t, loan.CreditorWallet.ClassicAddress.String(), tx["Destination"])
+		assert.Equal(t, "1000", tx["Amount"].(map[string]any)["value"])
+		paid, err := loans.GetLoan(tokenID)
+		assert.NoError(t, err)
+		assert.True(t, paid.NextPaymentDate.Equal(now.Add(loan.Period)))
+	}
+	assert.Equal(t, len(tokenIDs), node.Calls("submit"))
+}
+
+func TestLoan_AccruedInterest(t *testing.T) {
+	now := time.Now()
+	loan := newTestLoan(t)
+
+	loan.NextPaymentDate = now.Add(loan.Period)
+	assert.True(t, loan.AccruedInterest(now).IsZero())
+
+	loan.NextPaymentDate = now.Add(-12 * time.Hour).Add(loan.Period)
+	assert.Equal(t, "500", loan.AccruedInterest(now).String())
+}
+
+func TestLoans_ProcessLoan_CatchUp(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+
+	// The process was down for two days: the last payment was made two days ago
+	now := time.Now()
+	loan := newTestLoan(t)
+	loan.NextPaymentDate = now.Add(-48 * time.Hour).Add(loan.Period)
+	loans.AddLoan("token", loan)
+
+	assert.NoError(t, loans.processLoan("token", now))
+	assert.Equal(t, 1, node.Calls("submit"))
+
+	tx := submittedTx(t, node, 0)
+	amount := tx["Amount"].(map[string]any)
+	assert.Equal(t, "2000", amount["value"])
+}
+
+func TestLoans_ProcessDueLoans_RecoversFromTimeout(t *testing.T) {
+	// The node hangs on every request until it is told to answer again
+	var hang atomic.Bool
+	hang.Store(true)
+	unblock := make(chan struct{})
+	handlers := submitHandlers("tesSUCCESS")
+	for method, h := range handlers {
+		handlers[method] = func(params map[string]any) map[string]any {
+			if hang.Load() {
+				<-unblock
+			}
+			return h(params)
+		}
+	}
+	bc, node := newTestBlockchain(t, handlers)
+	t.Cleanup(func() { close(unblock) })
+
+	loans := newTestLoans(t, bc)
+	loans.paymentTimeout = 200 * time.Millisecond
+	loan := newTestLoan(t)
+	loan.NextPaymentDate = time.Now().Add(-24 * time.Hour).Add(loan.Period)
+	loans.AddLoan("token", loan)
+
+	start := time.Now()
+	err := loans.processLoan("token", time.Now())
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "got %v", err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	// The lock is released and the loan is left due for the next tick
+	released, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NoError(t, bc.TryLock(released))
+	bc.Unlock()
+	loans.processDueLoans()
+	due, err := loans.GetLoan("token")
+	assert.NoError(t, err)
+	assert.True(t, due.NextPaymentDate.Equal(loan.NextPaymentDate))
+	assert.Equal(t, 0, node.Calls("submit"))
+
+	// Once the node answers again the next tick pays the loan
+	hang.Store(false)
+	loans.processDueLoans()
+	paid, err := loans.GetLoan("token")
+	assert.NoError(t, err)
+	assert.True(t, paid.NextPaymentDate.After(time.Now()))
+	assert.Equal(t, 1, node.Calls("submit"))
+}
+
+func TestLoans_ProcessDueLoans_BatchesPayerLoans(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+
+	// Three loans of the same owner with the same creditor, all one day overdue
+	loan := newTestLoan(t)
+	loan.NextPaymentDate = time.Now().Add(-24 * time.Hour).Add(loan.Period)
+	tokenIDs := []string{"token-1", "token-2", "token-3"}
+	for _, tokenID := range tokenIDs {
+		loans.AddLoan(tokenID, loan)
+	}
+
+	loans.processDueLoans()
+
+	assert.Equal(t, 1, node.Calls("submit"))
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "Batch", tx["TransactionType"])
+	assert.Equal(t, loan.OwnerWallet.ClassicAddress.String(), tx["Account"])
+	rawTxs, ok := tx["RawTransactions"].([]any)
+	if assert.True(t, ok) && assert.Len(t, rawTxs, len(tokenIDs)) {
+		for _, raw := range rawTxs {
+			inner := raw.(map[string]any)["RawTransaction"].(map[string]any)
+			assert.Equal(t, "Payment", inner["TransactionType"])
+			assert.Equal(t, loan.CreditorWallet.ClassicAddress.String(), inner["Destination"])
+			// A day of interest, plus the time the test took to reach the payment
+			value, err := decimal.NewFromString(inner["Amount"].(map[string]any)["value"].(string))
+			assert.NoError(t, err)
+			assert.True(t, value.Sub(decimal.NewFromInt(1000)).Abs().LessThan(decimal.NewFromFloat(0.01)), "got %s", value)
+		}
+	}
+
+	for _, tokenID := range tokenIDs {
+		paid, err := loans.GetLoan(tokenID)
+		assert.NoError(t, err)
+		assert.True(t, paid.NextPaymentDate.After(time.Now()))
+	}
+}
+
+func TestLoans_ProcessLoanBatch_SinglePayment(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+
+	// Only one of the two loans has interest due, the other was just paid
+	now := time.Now()
+	due := newTestLoan(t)
+	due.NextPaymentDate = now.Add(-24 * time.Hour).Add(due.Period)
+	loans.AddLoan("token-1", due)
+	paid := newTestLoan(t)
+	paid.NextPaymentDate = now.Add(paid.Period)
+	loans.AddLoan("token-2", paid)
+
+	assert.NoError(t, loans.processLoanBatch([]string{"token-1", "token-2"}, now))
+
+	assert.Equal(t, 1, node.Calls("submit"))
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, "Payment", tx["TransactionType"])
+	assert.Equal(t, due.CreditorWallet.ClassicAddress.String(), tx["Destination"])
+	assert.Equal(t, "1000", tx["Amount"].(map[string]any)["value"])
+
+	advanced, err := loans.GetLoan("token-1")
+	assert.NoError(t, err)
+	assert.True(t, advanced.NextPaymentDate.Equal(now.Add(due.Period)))
+	unchanged, err := loans.GetLoan("token-2")
+	assert.NoError(t, err)
+	assert.True(t, unchanged.NextPaymentDate.Equal(paid.NextPaymentDate))
+}
+
+// cancelHandlers answers ledger_entry for a loan whose creditor holds the debt token until
+// the first submission returns it; the warrant token was never transferred.
+func cancelHandlers(debtTokenID string) map[string]rpcHandler {
+	var returned atomic.Bool
+	handlers := submitHandlers("tesSUCCESS")
+	submit := handlers["submit"]
+	handlers["submit"] = func(params map[string]any) map[string]any {
+		returned.Store(true)
+		return submit(params)
+	}
+	handlers["ledger_entry"] = func(params map[string]any) map[string]any {
+		if locator, ok := params["mptoken"].(map[string]any); ok &&
+			locator["mpt_issuance_id"] == debtTokenID && !returned.Load() {
+			return map[string]any{
+				"node":      map[string]any{"LedgerEntryType": "MPToken", "MPTAmount": "1"},
+				"validated": true,
+			}
+		}
+		if params["mpt_issuance"] == debtTokenID {
+			outstanding := "1"
+			if returned.Load() {
+				outstanding = "0"
+			}
+			return map[string]any{
+				"node":      map[string]any{"LedgerEntryType": "MPTokenIssuance", "OutstandingAmount": outstanding},
+				"validated": true,
+			}
+		}
+		return fixtureResult(entryNotFoundFixture)(params)
+	}
+	return handlers
+}
+
+func TestLoans_Cancel_HalfCompleted(t *testing.T) {
+	loan := newTestLoan(t)
+	tokenID, err := CreateIssuanceID(testAddress, 7)
+	assert.NoError(t, err)
+	debtTokenID, err := CreateIssuanceID(loan.OwnerWallet.ClassicAddress.String(), 1)
+	assert.NoError(t, err)
+
+	bc, node := newTestBlockchain(t, cancelHandlers(debtTokenID))
+	loans := newTestLoans(t, bc)
+
+	// The setup stopped after the debt token reached the creditor
+	loan.SetDebtTokenID(debtTokenID)
+	loan.Pending = true
+	loans.AddLoan(tokenID, loan)
+
+	assert.NoError(t, loans.Cancel(tokenID))
+	assert.Equal(t, 2, node.Calls("submit"))
+
+	returned := submittedTx(t, node, 0)
+	assert.Equal(t, "Payment", returned["TransactionType"])
+	assert.Equal(t, loan.CreditorWallet.ClassicAddress.String(), returned["Account"])
+	assert.Equal(t, loan.OwnerWallet.ClassicAddress.String(), returned["Destination"])
+	destroyed := submittedTx(t, node, 1)
+	assert.Equal(t, "MPTokenIssuanceDestroy", destroyed["TransactionType"])
+	assert.Equal(t, debtTokenID, destroyed["MPTokenIssuanceID"])
+
+	_, err = loans.GetLoan(tokenID)
+	assert.Error(t, err)
+
+	// Cancelling again does nothing
+	assert.NoError(t, loans.Cancel(tokenID))
+	assert.Equal(t, 2, node.Calls("submit"))
+}
+
+func TestLoans_Cancel_ResumesAfterBurn(t *testing.T) {
+	loan := newTestLoan(t)
+	tokenID, err := CreateIssuanceID(testAddress, 7)
+	assert.NoError(t, err)
+
+	bc, node := newTestBlockchain(t, cancelHandlers(""))
+	loans := newTestLoans(t, bc)
+
+	// A previous cancellation burnt the debt token and failed before repaying the principal
+	loan.PrincipalLent = true
+	loans.AddLoan(tokenID, loan)
+
+	assert.NoError(t, loans.Cancel(tokenID))
+	assert.Equal(t, 1, node.Calls("submit"))
+
+	repaid := submittedTx(t, node, 0)
+	assert.Equal(t, "Payment", repaid["TransactionType"])
+	assert.Equal(t, loan.OwnerWallet.ClassicAddress.String(), repaid["Account"])
+	assert.Equal(t, loan.CreditorWallet.ClassicAddress.String(), repaid["Destination"])
+	assert.Equal(t, "1000000", repaid["Amount"].(map[string]any)["value"])
+}
+
+func TestLoans_ProcessDueLoans_SkipsPending(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+
+	loan := newTestLoan(t)
+	loan.NextPaymentDate = time.Now().Add(-24 * time.Hour).Add(loan.Period)
+	loan.Pending = true
+	loans.AddLoan("token", loan)
+
+	loans.processDueLoans()
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestToken_CreditorSigners(t *testing.T) {
+	const creditor = "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	features := &config.FeatureConfig{CreditorSigners: []config.CreditorSigners{
+		{Address: creditor, Passes: []string{testHexSeed + "-3", testHexSeed + "-4"}},
+	}}
+	tokenAPI := createTestTokenWithFeatures(bc, features)
+
+	signers, err := tokenAPI.creditorSigners(creditor)
+	assert.NoError(t, err)
+	if assert.Len(t, signers, 2) {
+		for i, account := range []uint32{3, 4} {
+			want, err := crypto.NewWalletFromHexSeedIndex(testHexSeed, account)
+			assert.NoError(t, err)
+			assert.Equal(t, want.ClassicAddress, signers[i].ClassicAddress)
+		}
+	}
+
+	// A creditor that is not configured signs alone
+	signers, err = tokenAPI.creditorSigners(testAddress)
+	assert.NoError(t, err)
+	assert.Empty(t, signers)
+
+	features.CreditorSigners[0].Passes = []string{"not a pass"}
+	_, err = tokenAPI.creditorSigners(creditor)
+	assert.True(t, errors.Is(err, ErrInvalidPass), "got %v", err)
+}
+
+func TestLoans_ProcessDueLoans_SkipsLoanRemovedWhileWaiting(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+	loans.paymentTimeout = time.Second
+
+	loan := newTestLoan(t)
+	loan.NextPaymentDate = time.Now().Add(-24 * time.Hour).Add(loan.Period)
+	loans.AddLoan("token", loan)
+
+	// A buyout holds the lock while the accrual tick waits for it, and ends the loan
+	bc.Lock()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		loans.processDueLoans()
+	}()
+	time.Sleep(50 * time.Millisecond)
+	loans.RemoveLoan("token")
+	bc.Unlock()
+	<-done
+
+	// The ended loan is neither paid nor tracked again, and the next tick has nothing to pay
+	assert.Equal(t, 0, node.Calls("submit"))
+	_, err := loans.GetLoan("token")
+	assert.Error(t, err)
+	assert.NotPanics(t, loans.processDueLoans)
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestLoans_ProcessLoan_AdvancesTrackedLoanOnly(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	loans := newTestLoans(t, bc)
+
+	now := time.Now()
+	loan := newTestLoan(t)
+	loan.NextPaymentDate = now.Add(-24 * time.Hour).Add(loan.Period)
+	loans.AddLoan("token", loan)
+
+	assert.NoError(t, loans.processLoan("token", now))
+	paid, err := loans.GetLoan("token")
+	assert.NoError(t, err)
+	assert.True(t, paid.NextPaymentDate.Equal(now.Add(loan.Period)))
+
+	// A loan that is not tracked is skipped
+	assert.NoError(t, loans.processLoan("unknown", now))
+	_, err = loans.GetLoan("unknown")
+	assert.Error(t, err)
+}
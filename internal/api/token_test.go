@@ -0,0 +1,515 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/stretchr/testify/assert"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/config"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+	tokenv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/token/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// createTestToken creates a test instance of Token API with the loan feature disabled
+func createTestToken(bc *Blockchain) *Token {
+	return createTestTokenWithFeatures(bc, &config.FeatureConfig{RequireBalanceCheck: true})
+}
+
+// createTestTokenWithFeatures creates a test instance of Token API with the given feature flags
+func createTestTokenWithFeatures(bc *Blockchain, features *config.FeatureConfig) *Token {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewToken(logger, bc, features)
+}
+
+func TestNewToken_Features(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+
+	features := &config.FeatureConfig{Loan: true, LoanPaymentTimeout: 5, EmissionTimeout: 30}
+	tokenAPI := createTestTokenWithFeatures(bc, features)
+	assert.Same(t, features, tokenAPI.features)
+	assert.Same(t, bc, tokenAPI.loans.bc)
+	assert.NotNil(t, tokenAPI.loans.loans)
+	assert.Equal(t, 5*time.Second, tokenAPI.loans.paymentTimeout)
+	assert.Equal(t, 30*time.Second, tokenAPI.emissionTimeout)
+
+	tokenAPI = createTestTokenWithFeatures(bc, &config.FeatureConfig{})
+	assert.Nil(t, tokenAPI.loans.bc)
+}
+
+func TestToken_InvalidTokenID(t *testing.T) {
+	// Nil blockchain: validation must reject the request before any network call
+	tokenAPI := createTestToken(nil)
+	ctx := context.Background()
+	tokenID := "not-an-issuance-id"
+
+	_, err := tokenAPI.Transfer(ctx, &tokenv1.TransferRequest{TokenId: &tokenID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = tokenAPI.TransferToCreditor(ctx, &tokenv1.TransferToCreditorRequest{TokenId: &tokenID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = tokenAPI.BuyoutFromCreditor(ctx, &tokenv1.BuyoutFromCreditorRequest{TokenId: &tokenID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = tokenAPI.TransferFromOwnerToWarehouse(ctx, &tokenv1.TransferFromOwnerToWarehouseRequest{TokenId: &tokenID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = tokenAPI.TransferFromCreditorToWarehouse(ctx, &tokenv1.TransferFromCreditorToWarehouseRequest{TokenId: &tokenID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestToken_Emission_InvalidDocumentHash(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	tokenAPI := createTestToken(bc)
+
+	warehouse, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	owner, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/2")
+	assert.NoError(t, err)
+	ownerPass := testHexSeed + "-2"
+
+	_, err = tokenAPI.Emission(context.Background(), &tokenv1.EmissionRequest{
+		DocumentHash:       "not-a-hash",
+		WarehouseAddressId: warehouse.ClassicAddress.String(),
+		WarehousePass:      testHexSeed + "-1",
+		OwnerAddressId:     owner.ClassicAddress.String(),
+		OwnerPass:          &ownerPass,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 0, node.Calls("submit"))
+}
+
+func TestToken_Transfer_ZeroBalance(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = fixtureResult(entryNotFoundFixture)
+	bc, node := newTestBlockchain(t, handlers)
+	tokenAPI := createTestToken(bc)
+
+	sender, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	receiver, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/2")
+	assert.NoError(t, err)
+	receiverPass := testHexSeed + "-2"
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+
+	_, err = tokenAPI.Transfer(context.Background(), &tokenv1.TransferRequest{
+		DocumentHash:      "hash",
+		SenderAddressId:   sender.ClassicAddress.String(),
+		SenderPass:        testHexSeed + "-1",
+		ReceiverAddressId: receiver.ClassicAddress.String(),
+		ReceiverPass:      &receiverPass,
+		TokenId:           &tokenID,
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), ErrInsufficientMPTBalance.Error())
+
+	// Only the recipient authorization is submitted, the payment is rejected before submission
+	assert.Equal(t, 1, node.Calls("submit"))
+	params := node.Params("ledger_entry")
+	if assert.Len(t, params, 1) {
+		assert.Equal(t, map[string]any{"mpt_issuance_id": tokenID, "account": sender.ClassicAddress.String()}, params[0]["mptoken"])
+	}
+}
+
+func TestToken_Transfer_WithoutBalanceCheck(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["ledger_entry"] = fixtureResult(entryNotFoundFixture)
+	bc, node := newTestBlockchain(t, handlers)
+	tokenAPI := createTestTokenWithFeatures(bc, &config.FeatureConfig{RequireBalanceCheck: false})
+
+	sender, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	receiver, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/2")
+	assert.NoError(t, err)
+	receiverPass := testHexSeed + "-2"
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+
+	_, err = tokenAPI.Transfer(context.Background(), &tokenv1.TransferRequest{
+		DocumentHash:      "hash",
+		SenderAddressId:   sender.ClassicAddress.String(),
+		SenderPass:        testHexSeed + "-1",
+		ReceiverAddressId: receiver.ClassicAddress.String(),
+		ReceiverPass:      &receiverPass,
+		TokenId:           &tokenID,
+	})
+	assert.NoError(t, err)
+
+	// The payment is submitted without querying the sender balance
+	assert.Equal(t, 2, node.Calls("submit"))
+	assert.Equal(t, 0, node.Calls("ledger_entry"))
+}
+
+func TestToken_CreditorFlows_SameAccount(t *testing.T) {
+	owner, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	pass := testHexSeed + "-1"
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	tokenAPI := createTestTokenWithFeatures(bc, &config.FeatureConfig{Loan: true, RequireBalanceCheck: true})
+
+	_, err = tokenAPI.TransferToCreditor(context.Background(), &tokenv1.TransferToCreditorRequest{
+		DocumentHash:      "hash",
+		OwnerAddressId:    owner.ClassicAddress.String(),
+		OwnerAddressPass:  pass,
+		CreditorAddressId: owner.ClassicAddress.String(),
+		CreditorPass:      &pass,
+		TokenId:           &tokenID,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = tokenAPI.BuyoutFromCreditor(context.Background(), &tokenv1.BuyoutFromCreditorRequest{
+		DocumentHash:        "hash",
+		OwnerAddressId:      owner.ClassicAddress.String(),
+		OwnerPass:           &pass,
+		CreditorAddressId:   owner.ClassicAddress.String(),
+		CreditorAddressPass: pass,
+		TokenId:             &tokenID,
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestToken_TransferToCreditor_LoanFeature(t *testing.T) {
+	owner, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	creditor, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/2")
+	assert.NoError(t, err)
+	creditorPass := testHexSeed + "-2"
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+	req := &tokenv1.TransferToCreditorRequest{
+		DocumentHash:      "hash",
+		OwnerAddressId:    owner.ClassicAddress.String(),
+		OwnerAddressPass:  testHexSeed + "-1",
+		CreditorAddressId: creditor.ClassicAddress.String(),
+		CreditorPass:      &creditorPass,
+		TokenId:           &tokenID,
+	}
+
+	// The system account holds only its own reserve, so a loan cannot be funded
+	handlers := func() map[string]rpcHandler {
+		handlers := submitHandlers("tesSUCCESS")
+		handlers["account_info"] = accountInfoResult(testAddress, 0, "1000000")
+		return handlers
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		bc, node := newTestBlockchain(t, handlers())
+		tokenAPI := createTestTokenWithFeatures(bc, &config.FeatureConfig{Loan: true, RequireBalanceCheck: true})
+
+		_, err := tokenAPI.TransferToCreditor(context.Background(), req)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), ErrInsufficientSystemFunds.Error())
+		assert.Equal(t, 0, node.Calls("submit"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		handlers := handlers()
+		handlers["ledger_entry"] = fixtureResult(entryNotFoundFixture)
+		bc, node := newTestBlockchain(t, handlers)
+		tokenAPI := createTestTokenWithFeatures(bc, &config.FeatureConfig{Loan: false, RequireBalanceCheck: true})
+
+		_, err := tokenAPI.TransferToCreditor(context.Background(), req)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), ErrInsufficientMPTBalance.Error())
+		// The plain transfer authorizes the creditor without funding a loan
+		assert.Equal(t, 1, node.Calls("submit"))
+		assert.Equal(t, "MPTokenAuthorize", submittedTx(t, node, 0)["TransactionType"])
+	})
+}
+
+func TestToken_Transfer_AuthorizationFailure(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tecOBJECT_NOT_FOUND"))
+	tokenAPI := createTestToken(bc)
+
+	sender, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	receiver, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/2")
+	assert.NoError(t, err)
+	receiverPass := testHexSeed + "-2"
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+
+	_, err = tokenAPI.Transfer(context.Background(), &tokenv1.TransferRequest{
+		DocumentHash:      "hash",
+		SenderAddressId:   sender.ClassicAddress.String(),
+		SenderPass:        testHexSeed + "-1",
+		ReceiverAddressId: receiver.ClassicAddress.String(),
+		ReceiverPass:      &receiverPass,
+		TokenId:           &tokenID,
+	})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "failed to authorize token")
+	assert.Equal(t, 1, node.Calls("submit"))
+	assert.Equal(t, 0, node.Calls("ledger_entry"))
+}
+
+// emissionRequest returns an emission of the document by wallets derived from testHexSeed
+func emissionRequest(t *testing.T, documentHash string) *tokenv1.EmissionRequest {
+	t.Helper()
+	warehouse, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	owner, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/2")
+	assert.NoError(t, err)
+	ownerPass := testHexSeed + "-2"
+
+	return &tokenv1.EmissionRequest{
+		DocumentHash:       documentHash,
+		WarehouseAddressId: warehouse.ClassicAddress.String(),
+		WarehousePass:      testHexSeed + "-1",
+		OwnerAddressId:     owner.ClassicAddress.String(),
+		OwnerPass:          &ownerPass,
+	}
+}
+
+func TestToken_Emission_DuplicateDocument(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	// Waiting for validation reads the issuance sequence without the fire-and-forget delay
+	bc.SetSubmitMode(WaitForValidation)
+	tokenAPI := createTestToken(bc)
+
+	resp, err := tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	assert.NoError(t, err)
+	issuanceID := resp.GetToken().GetId()
+	assert.NotEmpty(t, issuanceID)
+	submits := node.Calls("submit")
+
+	// The same document in another spelling is rejected before any submission
+	_, err = tokenAPI.Emission(context.Background(), emissionRequest(t, "0xABCDEF01"))
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), issuanceID)
+	assert.Equal(t, submits, node.Calls("submit"))
+
+	_, err = tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef02"))
+	assert.NoError(t, err)
+}
+
+func TestToken_Emission_RegistersOnlyMintedDocuments(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tecINSUFFICIENT_RESERVE"))
+	tokenAPI := createTestToken(bc)
+	registry := NewMemoryIssuanceRegistry()
+	tokenAPI.SetIssuanceRegistry(registry)
+
+	_, err := tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	_, found, err := registry.Lookup(context.Background(), "abcdef01")
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+func TestToken_Emission_RegistersOnlyTransferredDocuments(t *testing.T) {
+	// The issuance is created and the owner authorized, but the transfer to the owner fails
+	handlers := submitHandlers("tesSUCCESS")
+	submit := handlers["submit"]
+	handlers["submit"] = func(params map[string]any) map[string]any {
+		result := submit(params)
+		if tx, ok := result["tx_json"].(map[string]any); ok && tx["TransactionType"] == "Payment" {
+			result["engine_result"] = "tecNO_AUTH"
+		}
+		return result
+	}
+	bc, _ := newTestBlockchain(t, handlers)
+	bc.SetSubmitMode(WaitForValidation)
+	tokenAPI := createTestToken(bc)
+	registry := NewMemoryIssuanceRegistry()
+	tokenAPI.SetIssuanceRegistry(registry)
+
+	_, err := tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	assert.Error(t, err)
+
+	// The document is left unregistered so the emission can be retried
+	_, found, err := registry.Lookup(context.Background(), "abcdef01")
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
+
+// failingIssuanceRegistry is an IssuanceRegistry whose Register always fails.
+type failingIssuanceRegistry struct {
+	*MemoryIssuanceRegistry
+}
+
+func (failingIssuanceRegistry) Register(context.Context, string, string) error {
+	return errors.New("registry unavailable")
+}
+
+func TestToken_Emission_RegisterFails(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetSubmitMode(WaitForValidation)
+	tokenAPI := createTestToken(bc)
+	tokenAPI.SetIssuanceRegistry(failingIssuanceRegistry{NewMemoryIssuanceRegistry()})
+
+	_, err := tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "registry unavailable")
+}
+
+func TestToken_Emission_InjectedRegistry(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	// Waiting for validation reads the issuance sequence without the fire-and-forget delay
+	bc.SetSubmitMode(WaitForValidation)
+	tokenAPI := createTestToken(bc)
+
+	priorID, err := CreateIssuanceID(testAddress, 3)
+	assert.NoError(t, err)
+	registry := NewMemoryIssuanceRegistry()
+	assert.NoError(t, registry.Register(context.Background(), "abcdef01", priorID))
+	tokenAPI.SetIssuanceRegistry(registry)
+
+	_, err = tokenAPI.Emission(context.Background(), emissionRequest(t, "ABCDEF01"))
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), priorID)
+	assert.Equal(t, 0, node.TotalCalls())
+
+	// Without a registry duplicates are not checked
+	tokenAPI.SetIssuanceRegistry(nil)
+	_, err = tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	assert.NoError(t, err)
+}
+
+func TestToken_RequestSignature(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetSubmitMode(WaitForValidation)
+	signer, err := crypto.NewWalletFromHexSeed(testHexSeed, "m/44'/144'/0'/0/9")
+	assert.NoError(t, err)
+	tokenAPI := createTestTokenWithFeatures(bc, &config.FeatureConfig{
+		RequireBalanceCheck: true,
+		RequestSignerKey:    signer.PublicKey,
+	})
+	sign := func(operation, docHash string) string {
+		signature, err := keypairs.Sign(crypto.RequestMessage(operation, docHash), signer.PrivateKey)
+		assert.NoError(t, err)
+		return signature
+	}
+
+	// Unsigned, forged and replayed signatures are rejected before any request to the node
+	for _, signature := range []string{"", "3006020101020101", sign("Transfer", "abcdef01"), sign("Emission", "abcdef02")} {
+		req := emissionRequest(t, "abcdef01")
+		req.Signature = signature
+		_, err = tokenAPI.Emission(context.Background(), req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), "signature %q", signature)
+	}
+
+	tokenID, err := CreateIssuanceID("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 7)
+	assert.NoError(t, err)
+	_, err = tokenAPI.TransferToCreditor(context.Background(), &tokenv1.TransferToCreditorRequest{
+		DocumentHash: "abcdef01",
+		Signature:    sign("Emission", "abcdef01"),
+		TokenId:      &tokenID,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, 0, node.TotalCalls())
+
+	req := emissionRequest(t, "abcdef01")
+	req.Signature = sign("Emission", "abcdef01")
+	_, err = tokenAPI.Emission(context.Background(), req)
+	assert.NoError(t, err)
+}
+
+func TestToken_Emission_TransactionHashes(t *testing.T) {
+	bc, _ := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetSubmitMode(WaitForValidation)
+	tokenAPI := createTestToken(bc)
+
+	resp, err := tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tx := resp.GetToken().GetTransaction()
+	if !assert.Len(t, tx.GetEvents(), 1) {
+		return
+	}
+	event := tx.GetEvents()[0]
+	assert.Equal(t, "Emission", event.GetName())
+	hashes := make(map[string]string)
+	for _, value := range event.GetValues() {
+		hashes[value.GetName()] = value.GetValue()
+	}
+
+	assert.NotEmpty(t, hashes["issuance_create_hash"])
+	assert.NotEmpty(t, hashes["authorize_hash"])
+	assert.Equal(t, tx.GetId(), hashes["transfer_hash"])
+	assert.NotEqual(t, hashes["issuance_create_hash"], hashes["transfer_hash"])
+	assert.NotEqual(t, hashes["authorize_hash"], hashes["transfer_hash"])
+	assert.NotEqual(t, hashes["issuance_create_hash"], hashes["authorize_hash"])
+}
+
+func TestToken_LockTimeout(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	tokenAPI := createTestToken(bc)
+	bc.Lock()
+	defer bc.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := tokenAPI.Emission(ctx, emissionRequest(t, "abcdef01"))
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Equal(t, 0, node.TotalCalls())
+}
+
+func TestToken_Emission_Deadline(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	submit := handlers["submit"]
+	var submits atomic.Int32
+	handlers["submit"] = func(params map[string]any) map[string]any {
+		// The node slows down after the issuance is created
+		if submits.Add(1) > 1 {
+			time.Sleep(300 * time.Millisecond)
+		}
+		return submit(params)
+	}
+	bc, _ := newTestBlockchain(t, handlers)
+	bc.SetSubmitMode(WaitForValidation)
+	tokenAPI := createTestToken(bc)
+	tokenAPI.SetEmissionTimeout(100 * time.Millisecond)
+
+	start := time.Now()
+	_, err := tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	assert.Less(t, time.Since(start), 300*time.Millisecond, "the emission returns at its deadline")
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "last completed step: issuance")
+	assert.Equal(t, int32(2), submits.Load(), "the transfer is not submitted")
+
+	// The lock is released for the next request
+	assert.NoError(t, bc.TryLock(context.Background()))
+	bc.Unlock()
+}
+
+func TestToken_Emission_Deadline_FireAndForget(t *testing.T) {
+	handlers := submitHandlers("tesSUCCESS")
+	tx := handlers["tx"]
+	handlers["tx"] = func(params map[string]any) map[string]any {
+		// The issuance is never seen validated
+		result := tx(params)
+		result["validated"] = false
+		return result
+	}
+	bc, node := newTestBlockchain(t, handlers)
+	bc.SetWaitOptions(WithMaxRetries(5), WithRetryDelay(time.Second))
+	tokenAPI := createTestToken(bc)
+	tokenAPI.SetEmissionTimeout(100 * time.Millisecond)
+
+	start := time.Now()
+	_, err := tokenAPI.Emission(context.Background(), emissionRequest(t, "abcdef01"))
+	assert.Less(t, time.Since(start), time.Second, "the issuance wait returns at the deadline")
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "last completed step: none")
+	assert.Equal(t, 1, node.Calls("submit"), "the owner is not authorized")
+
+	// The lock is released for the next request
+	assert.NoError(t, bc.TryLock(context.Background()))
+	bc.Unlock()
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/queries/account"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+)
+
+// mptokenObject is the account_objects type of the MPToken entries holding an issuance.
+const mptokenObject account.ObjectType = "mptoken"
+
+// EstimateTransferCost estimates the fees of transferring a warrant token to an account:
+// the payment alone if the recipient already holds the issuance, or the recipient's
+// MPTokenAuthorize plus the payment otherwise. Each transaction costs the base fee of
+// the latest validated ledger.
+//
+// Parameters:
+// - issuanceID: The MPT issuance ID
+// - to: The recipient account address
+//
+// Returns the summed fee in drops, an error wrapping ErrInvalidIssuanceID if the ID is
+// malformed, or an error if the ledger cannot be read.
+func (b *Blockchain) EstimateTransferCost(issuanceID, to string) (uint64, error) {
+	issuer, _, err := DecodeIssuanceID(issuanceID)
+	if err != nil {
+		return 0, err
+	}
+
+	authorized := issuer == to
+	if !authorized {
+		authorized, err = b.holdsMPToken(to, issuanceID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	info, err := b.GetBaseFeeAndReserve()
+	if err != nil {
+		return 0, err
+	}
+	fee := uint64(info.BaseFeeXRP * xrpToDrops)
+	if authorized {
+		return fee, nil
+	}
+	return 2 * fee, nil
+}
+
+// holdsMPToken reports whether the account owns an MPToken entry for the issuance,
+// that is whether it has authorized holding it, paging through its account objects.
+func (b *Blockchain) holdsMPToken(address, issuanceID string) (bool, error) {
+	var marker any
+	for {
+		resp, err := b.c.GetAccountObjects(&account.ObjectsRequest{
+			Account: types.Address(address),
+			Type:    mptokenObject,
+			Marker:  marker,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to get account objects: %w", err)
+		}
+		for _, object := range resp.AccountObjects {
+			if id, _ := object["MPTokenIssuanceID"].(string); strings.EqualFold(id, issuanceID) {
+				return true, nil
+			}
+		}
+		if resp.Marker == nil {
+			return false, nil
+		}
+		marker = resp.Marker
+	}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_EstimateTransferCost(t *testing.T) {
+	const recipient = "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"
+	issuanceID, err := CreateIssuanceID(testAddress, 1)
+	assert.NoError(t, err)
+	otherID, err := CreateIssuanceID(testAddress, 2)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		objects []any
+		want    uint64
+	}{
+		{
+			name:    "authorized recipient",
+			objects: []any{map[string]any{"LedgerEntryType": "MPToken", "MPTokenIssuanceID": issuanceID}},
+			want:    10,
+		},
+		{
+			name:    "unauthorized recipient",
+			objects: []any{map[string]any{"LedgerEntryType": "MPToken", "MPTokenIssuanceID": otherID}},
+			want:    20,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handlers := submitHandlers("tesSUCCESS")
+			handlers["account_objects"] = func(params map[string]any) map[string]any {
+				assert.Equal(t, recipient, params["account"])
+				assert.Equal(t, "mptoken", params["type"])
+				return map[string]any{"account": recipient, "account_objects": tt.objects}
+			}
+			bc, _ := newTestBlockchain(t, handlers)
+
+			cost, err := bc.EstimateTransferCost(issuanceID, recipient)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, cost)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+var (
+	// ErrTxTypeNotAllowed is returned when a transaction of a type outside the allowlist is submitted.
+	ErrTxTypeNotAllowed = errors.New("transaction type not allowed")
+)
+
+// SetAllowedTxTypes restricts the transaction types the service signs and submits, so that
+// a bug cannot send an unexpected transaction with the system wallet. Submissions of other
+// types fail with ErrTxTypeNotAllowed before any network call. Inner transactions of a Batch
+// are checked as well, so Batch and the inner types must all be allowed.
+//
+// Parameters:
+// - txTypes: The permitted transaction types, none allows every type
+func (b *Blockchain) SetAllowedTxTypes(txTypes ...transactions.TxType) {
+	if len(txTypes) == 0 {
+		b.allowedTypes = nil
+		return
+	}
+
+	b.allowedTypes = make(map[transactions.TxType]struct{}, len(txTypes))
+	for _, txType := range txTypes {
+		b.allowedTypes[txType] = struct{}{}
+	}
+}
+
+// checkTxTypeAllowed returns an error wrapping ErrTxTypeNotAllowed when the allowlist is set
+// and does not hold the transaction type.
+func (b *Blockchain) checkTxTypeAllowed(txType transactions.TxType) error {
+	if b.allowedTypes == nil {
+		return nil
+	}
+	if _, ok := b.allowedTypes[txType]; !ok {
+		return fmt.Errorf("%w: %s", ErrTxTypeNotAllowed, txType)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_SetAllowedTxTypes(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	bc.SetAllowedTxTypes(transaction.PaymentTx)
+
+	_, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.True(t, errors.Is(err, ErrTxTypeNotAllowed), "got %v", err)
+	_, err = bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.True(t, errors.Is(err, ErrTxTypeNotAllowed), "got %v", err)
+	_, _, err = bc.SignTx(bc.w, &transaction.AccountSet{})
+	assert.True(t, errors.Is(err, ErrTxTypeNotAllowed), "got %v", err)
+	blob, _, err := bc.w.Sign(map[string]any{
+		"TransactionType": "AccountSet",
+		"Account":         testAddress,
+		"Fee":             "12",
+		"Sequence":        uint32(10),
+		"Flags":           uint32(0),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	_, err = bc.SubmitBlob(blob, false)
+	assert.True(t, errors.Is(err, ErrTxTypeNotAllowed), "got %v", err)
+	assert.Equal(t, 0, node.TotalCalls())
+
+	// No types allows every type again
+	bc.SetAllowedTxTypes()
+	_, err = bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+}
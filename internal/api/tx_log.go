@@ -0,0 +1,92 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// redactedSignature replaces the signatures of logged transactions.
+const redactedSignature = "[REDACTED]"
+
+// EnableTransactionLog makes SubmitTx, SubmitTxAndWait and SubmitBlob log, at debug level, every
+// transaction just before it is submitted: the flattened transaction as signed, with its
+// signatures redacted, and its hash. The transaction is then autofilled and signed ahead
+// of the submission instead of by the client.
+//
+// Parameters:
+// - logger: The logger of the transactions, nil disables the log
+func (b *Blockchain) EnableTransactionLog(logger *slog.Logger) {
+	b.txLogger = logger
+}
+
+// logTransaction autofills and signs the transaction in place and logs it with its hash,
+// if the transaction log is enabled. The client submits an already signed transaction as is.
+func (b *Blockchain) logTransaction(w *wallet.Wallet, tx transactions.FlatTransaction) error {
+	if b.txLogger == nil {
+		return nil
+	}
+
+	if err := b.c.Autofill(&tx); err != nil {
+		return fmt.Errorf("failed to autofill tx: %w", err)
+	}
+	_, txHash, err := w.Sign(tx)
+	if err != nil {
+		return fmt.Errorf("failed to sign tx: %w", err)
+	}
+	return b.logSignedTransaction(txHash, tx)
+}
+
+// logSignedTransaction logs a signed transaction with its hash, if the transaction log is enabled.
+func (b *Blockchain) logSignedTransaction(txHash string, tx transactions.FlatTransaction) error {
+	if b.txLogger == nil {
+		return nil
+	}
+
+	redacted, err := json.Marshal(redactSignatures(tx))
+	if err != nil {
+		return fmt.Errorf("failed to encode tx for the log: %w", err)
+	}
+	b.txLogger.Debug("submitting transaction", "hash", txHash, "tx", string(redacted))
+	return nil
+}
+
+// redactSignatures returns a copy of the transaction with its signature and the signatures
+// of its signers replaced by redactedSignature.
+func redactSignatures(tx transactions.FlatTransaction) map[string]any {
+	redacted := make(map[string]any, len(tx))
+	for k, v := range tx {
+		redacted[k] = v
+	}
+	if _, ok := redacted["TxnSignature"]; ok {
+		redacted["TxnSignature"] = redactedSignature
+	}
+
+	signers, ok := tx["Signers"].([]any)
+	if !ok {
+		return redacted
+	}
+	redactedSigners := make([]any, len(signers))
+	for i, entry := range signers {
+		redactedSigners[i] = entry
+		wrapper, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		signer, ok := wrapper["Signer"].(map[string]any)
+		if !ok {
+			continue
+		}
+		copied := make(map[string]any, len(signer))
+		for k, v := range signer {
+			copied[k] = v
+		}
+		copied["TxnSignature"] = redactedSignature
+		redactedSigners[i] = map[string]any{"Signer": copied}
+	}
+	redacted["Signers"] = redactedSigners
+	return redacted
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_TransactionLog(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	var buf bytes.Buffer
+	bc.EnableTransactionLog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	hash, err := bc.SubmitTx(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+
+	signature, _ := submittedTx(t, node, 0)["TxnSignature"].(string)
+	assert.NotEmpty(t, signature)
+	logged := buf.String()
+	assert.Contains(t, logged, "AccountSet")
+	assert.Contains(t, logged, hash)
+	assert.Contains(t, logged, redactedSignature)
+	assert.NotContains(t, logged, signature)
+}
+
+func TestBlockchain_TransactionLog_SubmitBlob(t *testing.T) {
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	var buf bytes.Buffer
+	bc.EnableTransactionLog(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	blob, _, err := bc.w.Sign(testPaymentFlatTx())
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	hash, err := bc.SubmitBlob(blob, false)
+	assert.NoError(t, err)
+
+	signature, _ := submittedTx(t, node, 0)["TxnSignature"].(string)
+	assert.NotEmpty(t, signature)
+	logged := buf.String()
+	assert.Contains(t, logged, "Payment")
+	assert.Contains(t, logged, hash)
+	assert.Contains(t, logged, redactedSignature)
+	assert.NotContains(t, logged, signature)
+}
+
+func TestRedactSignatures(t *testing.T) {
+	tx := transaction.FlatTransaction{
+		"TransactionType": "Payment",
+		"Signers": []any{
+			map[string]any{"Signer": map[string]any{"Account": testAddress, "TxnSignature": "AB"}},
+		},
+	}
+	redacted := redactSignatures(tx)
+
+	signer := redacted["Signers"].([]any)[0].(map[string]any)["Signer"].(map[string]any)
+	assert.Equal(t, redactedSignature, signer["TxnSignature"])
+	assert.Equal(t, testAddress, signer["Account"])
+	assert.NotContains(t, redacted, "TxnSignature")
+	// The transaction itself is left as is
+	original := tx["Signers"].([]any)[0].(map[string]any)["Signer"].(map[string]any)
+	assert.Equal(t, "AB", original["TxnSignature"])
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	xrplcommon "github.com/Peersyst/xrpl-go/xrpl/common"
+	requests "github.com/Peersyst/xrpl-go/xrpl/queries/transactions"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+// ErrTxNotValidated is returned when a submitted transaction is not seen validated
+// before it expires or the retries are exhausted.
+var ErrTxNotValidated = errors.New("transaction not validated")
+
+// WaitOption configures how SubmitTxAndWait waits for a submitted transaction.
+type WaitOption func(*waitOptions)
+
+// waitOptions holds the retries of SubmitTxAndWait, zero values use the client defaults.
+type waitOptions struct {
+	maxRetries int
+	retryDelay time.Duration
+}
+
+// WithMaxRetries sets how many times a submitted transaction is looked up before giving up.
+//
+// Parameters:
+// - n: The number of lookups, zero or less keeps the client default of 10
+func WithMaxRetries(n int) WaitOption {
+	return func(o *waitOptions) {
+		o.maxRetries = n
+	}
+}
+
+// WithRetryDelay sets the delay between two lookups of a submitted transaction.
+//
+// Parameters:
+// - d: The delay, zero or less keeps the client default of one second
+func WithRetryDelay(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.retryDelay = d
+	}
+}
+
+// SetWaitOptions configures how SubmitTxAndWait waits for a submitted transaction to be
+// validated. The retries should cover the ledger offset, otherwise a transaction may
+// still be validated after the wait gave up.
+//
+// Parameters:
+// - opts: The wait options, none restores the client defaults
+func (b *Blockchain) SetWaitOptions(opts ...WaitOption) {
+	var options waitOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	b.wait = options
+}
+
+// awaitValidation waits for a transaction the node accepted to be validated. The client's
+// own wait is not used: it stops as soon as the ledger reaches LastLedgerSequence without
+// looking the transaction up, and its retries cannot be configured.
+//
+// Returns the validated transaction, or an error wrapping ErrTxNotValidated if the
+// transaction was not seen validated.
+func (b *Blockchain) awaitValidation(submitted transactions.FlatTransaction) (*requests.TxResponse, error) {
+	hash, _ := submitted["hash"].(string)
+	lastLedgerSequence, err := uint32Field(submitted, "LastLedgerSequence", true)
+	if err != nil {
+		return nil, err
+	}
+	return b.waitForTransaction(hash, lastLedgerSequence)
+}
+
+// sequenceConsumed reports whether a transaction the node answered with the engine result
+// uses its sequence: an applied or queued transaction does, even before it is validated.
+func sequenceConsumed(engineResult string) bool {
+	return engineResult == string(transactions.TesSUCCESS) || engineResult == string(transactions.TerQUEUED)
+}
+
+// waitForTransaction looks a submitted transaction up until it is validated. The ledger
+// index is read before each lookup: once that ledger is past LastLedgerSequence, a lookup
+// that still does not find the transaction validated means it can no longer be, while a
+// transaction validated in LastLedgerSequence itself is still found. Lookups of a
+// transaction the node does not know yet are retried. The wait stops once the context
+// bound by SetContext is done.
+func (b *Blockchain) waitForTransaction(hash string, lastLedgerSequence uint32) (*requests.TxResponse, error) {
+	maxRetries := b.wait.maxRetries
+	if maxRetries <= 0 {
+		maxRetries = xrplcommon.DefaultMaxRetries
+	}
+	retryDelay := b.wait.retryDelay
+	if retryDelay <= 0 {
+		retryDelay = xrplcommon.DefaultRetryDelay
+	}
+
+	ctx := b.boundContext()
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("stopped waiting for transaction %s: %w", hash, ctx.Err())
+			case <-time.After(retryDelay):
+			}
+		}
+
+		expired, err := b.IsTxExpired(lastLedgerSequence)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := b.lookupTransaction(hash)
+		switch {
+		case err == nil && resp.Validated:
+			return resp, nil
+		case err != nil && !isRPCError(err, "txnNotFound"):
+			return nil, err
+		case expired:
+			return nil, fmt.Errorf("%w: %s expired after ledger %d", ErrTxNotValidated, hash, lastLedgerSequence)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s after %d attempts", ErrTxNotValidated, hash, maxRetries)
+}
+
+// transactionFailure returns an error wrapping an EngineError if a validated transaction
+// did not succeed.
+func transactionFailure(resp *requests.TxResponse) error {
+	if meta, ok := resp.Meta.(map[string]any); ok {
+		if result, _ := meta["TransactionResult"].(string); result != "" && result != string(transactions.TesSUCCESS) {
+			return fmt.Errorf("transaction %s failed: %w", resp.Hash, &EngineError{Result: transactions.TxResult(result)})
+		}
+	}
+	return nil
+}
+
+// lookupTransaction fetches a transaction, validated or not, from the node.
+func (b *Blockchain) lookupTransaction(hash string) (*requests.TxResponse, error) {
+	res, err := b.c.Request(&requests.TxRequest{Transaction: hash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transaction %s: %w", hash, err)
+	}
+	var resp requests.TxResponse
+	if err := res.GetResult(&resp); err != nil {
+		return nil, fmt.Errorf("failed to decode transaction %s: %w", hash, err)
+	}
+	return &resp, nil
+}
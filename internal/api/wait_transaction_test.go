@@ -0,0 +1,132 @@
+package api
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+// pendingTxResult answers the tx method with an unvalidated transaction for the first
+// lookups, then with the transaction validated in the given ledger.
+func pendingTxResult(pending int32, calls *atomic.Int32, ledgerIndex uint32) rpcHandler {
+	return func(params map[string]any) map[string]any {
+		validated := calls.Add(1) > pending
+		result := map[string]any{
+			"hash":      params["transaction"],
+			"validated": validated,
+			"tx_json":   map[string]any{"Fee": "12"},
+		}
+		if validated {
+			result["ledger_index"] = ledgerIndex
+			result["meta"] = map[string]any{"TransactionResult": "tesSUCCESS"}
+		}
+		return result
+	}
+}
+
+func TestBlockchain_SubmitTxAndWait_WaitsForValidation(t *testing.T) {
+	var lookups atomic.Int32
+	var ledger atomic.Uint32
+	ledger.Store(100)
+
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["tx"] = pendingTxResult(2, &lookups, 105)
+	handlers["ledger"] = func(params map[string]any) map[string]any {
+		// Every poll sees the next ledger validated
+		return map[string]any{"ledger_index": ledger.Add(1) - 1, "validated": true}
+	}
+	bc, node := newTestBlockchain(t, handlers)
+	bc.SetWaitOptions(WithMaxRetries(5), WithRetryDelay(time.Millisecond))
+
+	hash, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, 3, node.Calls("tx"), "the transaction is looked up until it is validated")
+}
+
+func TestBlockchain_SubmitTxAndWait_RetriesExhausted(t *testing.T) {
+	var lookups atomic.Int32
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["tx"] = pendingTxResult(100, &lookups, 0)
+	bc, node := newTestBlockchain(t, handlers)
+	bc.SetWaitOptions(WithMaxRetries(3), WithRetryDelay(time.Millisecond))
+
+	_, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.True(t, errors.Is(err, ErrTxNotValidated))
+	assert.Equal(t, 3, node.Calls("tx"))
+}
+
+func TestBlockchain_SubmitTxAndWait_Expired(t *testing.T) {
+	var lookups atomic.Int32
+	var ledger atomic.Uint32
+	ledger.Store(100)
+
+	handlers := submitHandlers("tesSUCCESS")
+	handlers["tx"] = pendingTxResult(100, &lookups, 0)
+	handlers["ledger"] = func(params map[string]any) map[string]any {
+		// The ledger passes the LastLedgerSequence of 102 once the transaction is submitted
+		return map[string]any{"ledger_index": ledger.Swap(103), "validated": true}
+	}
+	bc, node := newTestBlockchain(t, handlers)
+	bc.SetLedgerOffset(2)
+	bc.SetWaitOptions(WithMaxRetries(10), WithRetryDelay(time.Millisecond))
+
+	_, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.True(t, errors.Is(err, ErrTxNotValidated))
+	assert.Equal(t, 1, node.Calls("tx"), "the transaction is looked up before giving up")
+}
+
+func TestBlockchain_SubmitTxAndWait_ValidatedAtLastLedgerSequence(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pending int32
+		ledger  uint32
+	}{
+		"validated ledger is LastLedgerSequence":      {pending: 2, ledger: 102},
+		"validated ledger is past LastLedgerSequence": {pending: 0, ledger: 103},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var lookups atomic.Int32
+			var ledger atomic.Uint32
+			ledger.Store(100)
+
+			handlers := submitHandlers("tesSUCCESS")
+			handlers["tx"] = pendingTxResult(tc.pending, &lookups, 102)
+			handlers["ledger"] = func(params map[string]any) map[string]any {
+				// LastLedgerSequence is set to 102, then the wait sees the given ledger
+				return map[string]any{"ledger_index": ledger.Swap(tc.ledger), "validated": true}
+			}
+			bc, node := newTestBlockchain(t, handlers)
+			bc.SetLedgerOffset(2)
+			bc.SetWaitOptions(WithMaxRetries(5), WithRetryDelay(time.Millisecond))
+
+			hash, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+			assert.NoError(t, err)
+			assert.NotEmpty(t, hash)
+			assert.EqualValues(t, 102, submittedTx(t, node, 0)["LastLedgerSequence"])
+			assert.Equal(t, int(tc.pending)+1, node.Calls("tx"))
+		})
+	}
+}
+
+func TestBlockchain_SubmitTxAndWait_NotFoundYet(t *testing.T) {
+	var lookups atomic.Int32
+	handlers := submitHandlers("tesSUCCESS")
+	validated := handlers["tx"]
+	handlers["tx"] = func(params map[string]any) map[string]any {
+		// The node does not know the transaction at the first lookup
+		if lookups.Add(1) == 1 {
+			return map[string]any{"error": "txnNotFound", "status": "error"}
+		}
+		return validated(params)
+	}
+	bc, node := newTestBlockchain(t, handlers)
+	bc.SetWaitOptions(WithMaxRetries(5), WithRetryDelay(time.Millisecond))
+
+	_, err := bc.SubmitTxAndWait(bc.w, &transaction.AccountSet{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, node.Calls("tx"))
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/crypto"
+)
+
+var (
+	// ErrInvalidPass is returned when a pass is not in the "hexSeed-accountIndex" format.
+	ErrInvalidPass = errors.New("invalid pass format")
+)
+
+// EnableWalletCache keeps up to size wallets derived by WalletFromHexSeed.
+//
+// Parameters:
+// - size: The maximum number of cached wallets, zero or negative disables the cache
+func (b *Blockchain) EnableWalletCache(size int) {
+	if size <= 0 {
+		b.wallets = nil
+		return
+	}
+	b.wallets = crypto.NewWalletCache(size)
+}
+
+// WalletFromHexSeed returns the wallet of a hex seed and BIP-44 derivation path,
+// reusing a previously derived wallet when the wallet cache is enabled.
+// Derivation needs no node, so a nil Blockchain derives the wallet directly.
+// The returned wallet may be shared and must not be modified.
+//
+// Parameters:
+// - hexSeed: A hexadecimal string representing the master seed
+// - path: The BIP-44 derivation path (e.g., "m/44'/144'/0'/0/0")
+//
+// Returns the wallet or an error if derivation fails.
+func (b *Blockchain) WalletFromHexSeed(hexSeed string, path string) (*wallet.Wallet, error) {
+	if b == nil {
+		return crypto.NewWalletFromHexSeed(hexSeed, path)
+	}
+	return b.wallets.WalletFromHexSeed(hexSeed, path)
+}
+
+// WalletFromHexSeedIndex returns the wallet of a hex seed and account index of the
+// standard XRPL derivation path, reusing a previously derived wallet when the wallet
+// cache is enabled. A nil Blockchain derives the wallet directly.
+// The returned wallet may be shared and must not be modified.
+//
+// Parameters:
+// - hexSeed: A hexadecimal string representing the master seed
+// - account: The account index, the last component of m/44'/144'/0'/0/<account>
+//
+// Returns the wallet or an error if derivation fails.
+func (b *Blockchain) WalletFromHexSeedIndex(hexSeed string, account uint32) (*wallet.Wallet, error) {
+	if b == nil {
+		return crypto.NewWalletFromHexSeedIndex(hexSeed, account)
+	}
+	return b.wallets.WalletFromHexSeedIndex(hexSeed, account)
+}
+
+// WalletFromPass returns the wallet of a pass in the "hexSeed-accountIndex" format
+// used by the service requests.
+//
+// Parameters:
+// - pass: The hex seed and account index joined by a dash
+//
+// Returns the wallet, an error wrapping ErrInvalidPass if the pass is malformed,
+// or an error if derivation fails.
+func (b *Blockchain) WalletFromPass(pass string) (*wallet.Wallet, error) {
+	hexSeed, account, err := parsePass(pass)
+	if err != nil {
+		return nil, err
+	}
+	return b.WalletFromHexSeedIndex(hexSeed, account)
+}
+
+// parsePass splits a "hexSeed-accountIndex" pass into the seed and account index.
+func parsePass(pass string) (hexSeed string, account uint32, err error) {
+	hexSeed, index, ok := strings.Cut(pass, "-")
+	if !ok || hexSeed == "" || strings.Contains(index, "-") {
+		return "", 0, ErrInvalidPass
+	}
+	n, err := strconv.ParseUint(index, 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("%w: account index %q", ErrInvalidPass, index)
+	}
+	return hexSeed, uint32(n), nil
+}
@@ -0,0 +1,21 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockchain_WalletFromPass(t *testing.T) {
+	var bc *Blockchain
+
+	w, err := bc.WalletFromPass(testHexSeed + "-0")
+	if assert.NoError(t, err) {
+		assert.Equal(t, testAddress, w.ClassicAddress.String())
+	}
+
+	for _, pass := range []string{"", testHexSeed, testHexSeed + "-", testHexSeed + "-x", testHexSeed + "-1-2", "-0"} {
+		_, err := bc.WalletFromPass(pass)
+		assert.ErrorIs(t, err, ErrInvalidPass, "pass %q", pass)
+	}
+}
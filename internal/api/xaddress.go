@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	addresscodec "github.com/Peersyst/xrpl-go/address-codec"
+	transactions "github.com/Peersyst/xrpl-go/xrpl/transaction"
+)
+
+var (
+	// ErrInvalidXAddress is returned when an X-address is malformed or fails its checksum.
+	ErrInvalidXAddress = errors.New("invalid X-address")
+	// ErrTagMismatch is returned when an X-address tag conflicts with the tag field of the transaction.
+	ErrTagMismatch = errors.New("X-address tag does not match transaction tag")
+)
+
+// xAddressPayloadLength is the decoded X-address length without checksum:
+// 2-byte network prefix, 20-byte account ID, 1-byte tag flag and 8-byte tag.
+const xAddressPayloadLength = 31
+
+// ClassicToXAddress encodes a classic address and destination tag as an X-address.
+// A zero tag produces an X-address without a tag.
+//
+// Parameters:
+// - addr: The classic account address
+// - tag: The destination tag, 0 for none
+// - testnet: Whether to encode for test networks
+//
+// Returns the X-address or an error if the classic address is invalid.
+func ClassicToXAddress(addr string, tag uint32, testnet bool) (string, error) {
+	xAddress, err := addresscodec.ClassicAddressToXAddress(addr, tag, tag != 0, testnet)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode X-address: %w", err)
+	}
+	return xAddress, nil
+}
+
+// XAddressToClassic decodes an X-address into its classic address and tag.
+// Unlike the address codec it verifies the checksum and decodes the full 32-bit tag.
+//
+// Parameters:
+// - xAddress: The X-address to decode
+//
+// Returns the classic address, the tag (0 if none), whether the address is for test networks,
+// or an error wrapping ErrInvalidXAddress.
+func XAddressToClassic(xAddress string) (classic string, tag uint32, testnet bool, err error) {
+	payload, err := addresscodec.Base58CheckDecode(xAddress)
+	if err != nil {
+		return "", 0, false, fmt.Errorf("%w: %v", ErrInvalidXAddress, err)
+	}
+	if len(payload) != xAddressPayloadLength {
+		return "", 0, false, fmt.Errorf("%w: unexpected length %d", ErrInvalidXAddress, len(payload))
+	}
+
+	switch {
+	case bytes.HasPrefix(payload, addresscodec.MainnetXAddressPrefix):
+		testnet = false
+	case bytes.HasPrefix(payload, addresscodec.TestnetXAddressPrefix):
+		testnet = true
+	default:
+		return "", 0, false, fmt.Errorf("%w: unknown prefix", ErrInvalidXAddress)
+	}
+
+	switch payload[22] {
+	case 0:
+		tag = 0
+	case 1:
+		tag = binary.LittleEndian.Uint32(payload[23:27])
+	default:
+		return "", 0, false, fmt.Errorf("%w: invalid tag flag", ErrInvalidXAddress)
+	}
+	if binary.LittleEndian.Uint32(payload[27:31]) != 0 {
+		return "", 0, false, fmt.Errorf("%w: 64-bit tags are not supported", ErrInvalidXAddress)
+	}
+
+	classic, err = addresscodec.EncodeAccountIDToClassicAddress(payload[2:22])
+	if err != nil {
+		return "", 0, false, fmt.Errorf("%w: %v", ErrInvalidXAddress, err)
+	}
+
+	return classic, tag, testnet, nil
+}
+
+// resolveXAddresses replaces X-addresses in the Account and Destination fields with
+// classic addresses and moves their tags into SourceTag and DestinationTag.
+// The rpc client leaves X-addresses untouched, which would drop the embedded tag.
+func resolveXAddresses(tx transactions.FlatTransaction) error {
+	for _, f := range []struct{ address, tag string }{
+		{address: "Account", tag: "SourceTag"},
+		{address: "Destination", tag: "DestinationTag"},
+	} {
+		address, ok := tx[f.address].(string)
+		if !ok || !addresscodec.IsValidXAddress(address) {
+			continue
+		}
+
+		classic, tag, _, err := XAddressToClassic(address)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", f.address, err)
+		}
+		tx[f.address] = classic
+
+		if tag == 0 {
+			continue
+		}
+		if txTag, ok := tx[f.tag].(uint32); ok && txTag != tag {
+			return fmt.Errorf("%w: %s %d, %s %d", ErrTagMismatch, f.tag, txTag, f.address, tag)
+		}
+		tx[f.tag] = tag
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXAddress_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     uint32
+		testnet bool
+	}{
+		{name: "no tag mainnet", tag: 0, testnet: false},
+		{name: "tag testnet", tag: 12345, testnet: true},
+		{name: "max tag", tag: 4294967295, testnet: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xAddress, err := ClassicToXAddress(testAddress, tt.tag, tt.testnet)
+			assert.NoError(t, err)
+			assert.NotEqual(t, testAddress, xAddress)
+
+			classic, tag, testnet, err := XAddressToClassic(xAddress)
+			assert.NoError(t, err)
+			assert.Equal(t, testAddress, classic)
+			assert.Equal(t, tt.tag, tag)
+			assert.Equal(t, tt.testnet, testnet)
+		})
+	}
+}
+
+func TestXAddressToClassic_Invalid(t *testing.T) {
+	xAddress, err := ClassicToXAddress(testAddress, 1, false)
+	assert.NoError(t, err)
+
+	// Flip the last character to break the checksum
+	last := xAddress[len(xAddress)-1]
+	replacement := byte('a')
+	if last == replacement {
+		replacement = 'b'
+	}
+	corrupted := xAddress[:len(xAddress)-1] + string(replacement)
+
+	for _, addr := range []string{"", testAddress, corrupted} {
+		_, _, _, err := XAddressToClassic(addr)
+		assert.True(t, errors.Is(err, ErrInvalidXAddress), "address %q: %v", addr, err)
+	}
+}
+
+func TestBlockchain_SubmitTx_XAddressDestination(t *testing.T) {
+	destination := "rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe"
+	xAddress, err := ClassicToXAddress(destination, 42, true)
+	assert.NoError(t, err)
+
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	_, err = bc.SubmitTx(bc.w, &transaction.Payment{
+		Amount:      types.XRPCurrencyAmount(1000),
+		Destination: types.Address(xAddress),
+	})
+	assert.NoError(t, err)
+
+	tx := submittedTx(t, node, 0)
+	assert.Equal(t, destination, tx["Destination"])
+	assert.Equal(t, uint32(42), tx["DestinationTag"])
+}
+
+func TestBlockchain_SubmitTx_XAddressTagMismatch(t *testing.T) {
+	xAddress, err := ClassicToXAddress("rPT1Sjq2YGrBMTttX4GZHjKu9dyfzbpAYe", 42, true)
+	assert.NoError(t, err)
+
+	bc, node := newTestBlockchain(t, submitHandlers("tesSUCCESS"))
+	tag := uint32(7)
+	_, err = bc.SubmitTx(bc.w, &transaction.Payment{
+		Amount:         types.XRPCurrencyAmount(1000),
+		Destination:    types.Address(xAddress),
+		DestinationTag: &tag,
+	})
+	assert.True(t, errors.Is(err, ErrTagMismatch))
+	assert.Equal(t, 0, node.TotalCalls())
+}
@@ -4,8 +4,13 @@
 package config
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
 
+	"github.com/Peersyst/xrpl-go/keypairs"
 	"github.com/spf13/viper"
 	"github.com/ucarion/redact"
 )
@@ -33,6 +38,73 @@ type NetworkConfig struct {
 	// This applies to all RPC calls to the XRPL network.
 	Timeout int64 `mapstructure:"timeout"`
 
+	// Headers specifies additional HTTP headers sent with every RPC request.
+	// Some hosted XRPL providers require an API key header.
+	Headers map[string]string `mapstructure:"headers"`
+
+	// AuthToken specifies a bearer token sent in the Authorization header.
+	// Leave empty for public nodes.
+	AuthToken string `mapstructure:"auth_token"`
+
+	// DetectNetworkID specifies whether the network ID is read from the node at startup.
+	// Sidechains with an ID above 1024 require the NetworkID field on every transaction.
+	// Disable for offline construction.
+	DetectNetworkID bool `mapstructure:"detect_network_id"`
+
+	// CheckAmendments specifies whether startup fails when the node lacks an amendment
+	// the service relies on, such as MPTokensV1. Disable for offline construction.
+	CheckAmendments bool `mapstructure:"check_amendments"`
+
+	// AccountCacheTTL specifies how long account info responses are cached, in seconds.
+	// The cached entry of an account is dropped whenever it submits a transaction.
+	// Zero disables the cache.
+	AccountCacheTTL int64 `mapstructure:"account_cache_ttl"`
+
+	// SubmitMode specifies whether submissions that need the outcome wait for validation.
+	// Valid values: "fire_and_forget" (default), "wait_for_validation"
+	SubmitMode string `mapstructure:"submit_mode"`
+
+	// LedgerOffset specifies how many ledgers a submitted transaction stays valid for.
+	// Raise it for transactions expected to queue. Zero uses the client default of 20.
+	LedgerOffset uint32 `mapstructure:"ledger_offset"`
+
+	// ConfirmationDepth specifies how many validated ledgers, counting its own, a transaction
+	// needs before it is reported final. Zero or one treats a validated transaction as final.
+	ConfirmationDepth uint32 `mapstructure:"confirmation_depth"`
+
+	// MaxBatchSize specifies the most inner transactions sent in a single Batch; larger
+	// transfers are split into several batches. Zero uses the protocol maximum of 8,
+	// any other value must be at least 2.
+	MaxBatchSize uint32 `mapstructure:"max_batch_size"`
+
+	// WalletCacheSize specifies how many wallets derived from request passwords are kept,
+	// sparing the BIP-44 derivation on repeated requests for the same account.
+	// Zero disables the cache.
+	WalletCacheSize int `mapstructure:"wallet_cache_size"`
+
+	// CorrelationMemo specifies whether submitted transactions carry a memo with the SHA-256
+	// of the request correlation ID, linking ledger transactions to the service logs.
+	CorrelationMemo bool `mapstructure:"correlation_memo"`
+
+	// LogTransactions specifies whether every submitted transaction is logged at debug level,
+	// with its signatures redacted, for diagnosing failed submissions.
+	LogTransactions bool `mapstructure:"log_transactions"`
+
+	// AllowedTxTypes specifies the transaction types the service may submit, such as
+	// "Payment" or "MPTokenAuthorize". Leave empty to allow every type.
+	AllowedTxTypes []string `mapstructure:"allowed_tx_types"`
+
+	// AuditLog specifies the path of the append-only audit log of submitted transactions.
+	// Leave empty to disable auditing.
+	AuditLog string `mapstructure:"audit_log"`
+
+	// Faucet contains configuration for the test network faucet funding new accounts.
+	Faucet struct {
+		// URL specifies the faucet accounts endpoint, such as
+		// "https://faucet.altnet.rippletest.net/accounts". Leave empty to disable funding.
+		URL string `mapstructure:"url"`
+	} `mapstructure:"faucet"`
+
 	// System contains configuration for the system account used by the service.
 	System struct {
 		// Account specifies the system account's XRPL address.
@@ -49,12 +121,186 @@ type NetworkConfig struct {
 	} `mapstructure:"system"`
 }
 
+var (
+	// ErrInvalidNetworkConfig is returned when the network configuration is unusable.
+	ErrInvalidNetworkConfig = errors.New("invalid network config")
+)
+
+// keyHexLength is the length of an XRPL public or private key in hex characters,
+// a one byte algorithm prefix followed by 32 bytes of key material.
+const keyHexLength = 66
+
+// keyCheckMessage is signed with the system secret to check it matches the public key.
+const keyCheckMessage = "system key check"
+
+// Validate checks the network configuration before any connection is made.
+// The RPC URL and the faucet URL, if any, must be http(s), the timeout positive, and the
+// system secret, public key and account must belong to the same keypair.
+// The secret is never included in errors.
+//
+// Returns an error wrapping ErrInvalidNetworkConfig describing the first problem found.
+func (c NetworkConfig) Validate() error {
+	u, err := url.Parse(c.URL)
+	if err != nil {
+		return fmt.Errorf("%w: url: %v", ErrInvalidNetworkConfig, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w: url %q must use http or https", ErrInvalidNetworkConfig, c.URL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%w: url %q has no host", ErrInvalidNetworkConfig, c.URL)
+	}
+
+	if c.Timeout <= 0 {
+		return fmt.Errorf("%w: timeout must be positive, got %d", ErrInvalidNetworkConfig, c.Timeout)
+	}
+
+	if c.Faucet.URL != "" {
+		u, err := url.Parse(c.Faucet.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%w: faucet url %q must be an http(s) url", ErrInvalidNetworkConfig, c.Faucet.URL)
+		}
+	}
+
+	return c.validateSystemAccount()
+}
+
+// validateSystemAccount checks the system secret signs for the public key and that the
+// public key derives the system account address.
+func (c NetworkConfig) validateSystemAccount() error {
+	if err := validateKeyHex(c.System.Secret); err != nil {
+		return fmt.Errorf("%w: system secret: %v", ErrInvalidNetworkConfig, err)
+	}
+	if err := validateKeyHex(c.System.Public); err != nil {
+		return fmt.Errorf("%w: system public key: %v", ErrInvalidNetworkConfig, err)
+	}
+
+	signature, err := keypairs.Sign(keyCheckMessage, c.System.Secret)
+	if err != nil {
+		return fmt.Errorf("%w: system secret cannot sign: %v", ErrInvalidNetworkConfig, err)
+	}
+	ok, err := keypairs.Validate(keyCheckMessage, c.System.Public, signature)
+	if err != nil || !ok {
+		return fmt.Errorf("%w: system secret does not match the system public key", ErrInvalidNetworkConfig)
+	}
+
+	address, err := keypairs.DeriveClassicAddress(c.System.Public)
+	if err != nil {
+		return fmt.Errorf("%w: system public key: %v", ErrInvalidNetworkConfig, err)
+	}
+	if address != c.System.Account {
+		return fmt.Errorf("%w: system account %q does not match the address %q derived from the system keys",
+			ErrInvalidNetworkConfig, c.System.Account, address)
+	}
+	return nil
+}
+
+// validateKeyHex checks the key is a prefixed 33-byte hex key, as the keypairs package expects.
+func validateKeyHex(key string) error {
+	if len(key) != keyHexLength {
+		return fmt.Errorf("expected %d hex characters, got %d", keyHexLength, len(key))
+	}
+	if _, err := hex.DecodeString(key); err != nil {
+		return errors.New("not a hex string")
+	}
+	return nil
+}
+
 // FeatureConfig holds configuration for feature flags.
 // It controls which features are enabled or disabled in the application.
 type FeatureConfig struct {
 	// Loan specifies whether the loan feature is enabled.
 	// When true, loan-related functionality will be available.
 	Loan bool `mapstructure:"loan"`
+
+	// LoanPaymentTimeout specifies the deadline of a single loan interest payment, in seconds.
+	// A payment that times out is skipped and retried on the next accrual tick.
+	// Zero disables the deadline.
+	LoanPaymentTimeout int64 `mapstructure:"loan_payment_timeout"`
+
+	// EmissionTimeout specifies the deadline of a whole emission, from minting the token
+	// to transferring it to the owner, in seconds. Zero disables the deadline.
+	EmissionTimeout int64 `mapstructure:"emission_timeout"`
+
+	// RequireBalanceCheck specifies whether token transfers check the sender holds the token
+	// before submitting, so that they fail fast instead of with a ledger error.
+	RequireBalanceCheck bool `mapstructure:"require_balance_check"`
+
+	// WaitForValidation specifies whether token transfers return only once validated in a ledger.
+	// When false, they return as soon as the node accepts them.
+	WaitForValidation bool `mapstructure:"wait_for_validation"`
+
+	// RequestSignerKey specifies the hex public key whose signature authorizes token operations.
+	// Emissions, transfers and the creditor flows are rejected unless their Signature field
+	// signs "<method>:<document hash>" with this key. Leave empty to skip verification.
+	RequestSignerKey string `mapstructure:"request_signer_key"`
+
+	// CreditorSigners lists the creditors configured as multi-sig. Tokens are returned from
+	// such a creditor with transfers multi-signed by its signers.
+	CreditorSigners []CreditorSigners `mapstructure:"creditor_signers"`
+}
+
+// CreditorSigners holds the signers of a multi-sig creditor account.
+type CreditorSigners struct {
+	// Address specifies the creditor account address.
+	Address string `mapstructure:"address"`
+
+	// Passes specifies the signer list members that sign for the creditor, in the
+	// "hexSeed-accountIndex" format of the requests.
+	Passes []string `mapstructure:"passes"`
+}
+
+// maxTickerLength is the longest ticker the XLS-89 token metadata standard allows.
+const maxTickerLength = 6
+
+// ErrInvalidMetadataConfig is returned when the token metadata defaults are unusable.
+var ErrInvalidMetadataConfig = errors.New("invalid metadata config")
+
+// MetadataDefaults holds the parts of the warrant token metadata that are the same for
+// every warrant: the ticker, the display name and description, and the links to the
+// issuer home page and rulebook. Empty fields keep the built-in values.
+type MetadataDefaults struct {
+	// Ticker specifies the token ticker, up to 6 uppercase letters or digits.
+	Ticker string `mapstructure:"ticker"`
+
+	// Name specifies the display name of the token.
+	Name string `mapstructure:"name"`
+
+	// Desc specifies the short description of the token.
+	Desc string `mapstructure:"desc"`
+
+	// HomeURL specifies the issuer website linked from the metadata.
+	HomeURL string `mapstructure:"home_url"`
+
+	// RulebookURL specifies the legal framework document linked from the metadata.
+	RulebookURL string `mapstructure:"rulebook_url"`
+}
+
+// Validate checks the ticker, if set, is 1 to 6 uppercase letters or digits as XLS-89
+// requires, and the URLs, if set, are https URLs.
+//
+// Returns an error wrapping ErrInvalidMetadataConfig describing the first problem found.
+func (m MetadataDefaults) Validate() error {
+	if m.Ticker != "" {
+		if len(m.Ticker) > maxTickerLength {
+			return fmt.Errorf("%w: ticker %q is longer than %d characters", ErrInvalidMetadataConfig, m.Ticker, maxTickerLength)
+		}
+		for _, r := range m.Ticker {
+			if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+				return fmt.Errorf("%w: ticker %q must be uppercase letters or digits", ErrInvalidMetadataConfig, m.Ticker)
+			}
+		}
+	}
+	for _, link := range []string{m.HomeURL, m.RulebookURL} {
+		if link == "" {
+			continue
+		}
+		u, err := url.Parse(link)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%w: url %q must be an https URL", ErrInvalidMetadataConfig, link)
+		}
+	}
+	return nil
 }
 
 // Config contains all configuration parameters for the application.
@@ -69,6 +315,9 @@ type Config struct {
 	// Features contains feature flag configuration settings.
 	Features FeatureConfig `mapstructure:"features"`
 
+	// Metadata contains the defaults of the warrant token metadata.
+	Metadata MetadataDefaults `mapstructure:"metadata"`
+
 	// Server contains HTTP/gRPC server configuration.
 	Server struct {
 		// Listen specifies the address and port for the server to listen on.
@@ -117,6 +366,13 @@ func (c *Config) FeatureConfig() *FeatureConfig {
 	return &c.Features
 }
 
+// MetadataDefaults returns the warrant token metadata defaults from the config values.
+//
+// Returns the MetadataDefaults section of the main configuration.
+func (c *Config) MetadataDefaults() MetadataDefaults {
+	return c.Metadata
+}
+
 // RedactedConfigLog returns a string representation of the config with sensitive fields redacted.
 // Uses github.com/ucarion/redact for redaction to prevent logging of sensitive information
 // like private keys, passwords, and API tokens.
@@ -130,6 +386,9 @@ func (c *Config) RedactedConfigLog() string {
 	// List of sensitive fields to redact (add as needed, e.g. "api_key", "password")
 	sensitiveFields := [][]string{
 		{"Network", "System", "Secret"},
+		{"Network", "AuthToken"},
+		{"Network", "Headers"},
+		{"Features", "CreditorSigners"},
 		// Example: {"Database", "Password"},
 	}
 	cfgCopy := *c
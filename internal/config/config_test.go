@@ -0,0 +1,72 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validNetworkConfig() NetworkConfig {
+	cfg := NetworkConfig{
+		URL:     "https://s.devnet.rippletest.net:51234",
+		Timeout: 30,
+	}
+	cfg.System.Account = "rspQjo1QqBZsH3Kbr7Z7SbF7QGRAbWsppE"
+	cfg.System.Public = "EDF8E0C585E753AF306F88718CAD36968498FDC11C3B762FB8ACEAA6B3AEF59ECD"
+	cfg.System.Secret = "ED314A5DDC93264D2CB91E1ED92B69F9AEDB7FA14027649D3F300B462B0355DEDD"
+	return cfg
+}
+
+func TestNetworkConfig_Validate(t *testing.T) {
+	assert.NoError(t, validNetworkConfig().Validate())
+
+	tests := []struct {
+		name   string
+		modify func(cfg *NetworkConfig)
+	}{
+		{name: "websocket url", modify: func(cfg *NetworkConfig) { cfg.URL = "wss://s.devnet.rippletest.net:51233" }},
+		{name: "url without host", modify: func(cfg *NetworkConfig) { cfg.URL = "http://" }},
+		{name: "empty url", modify: func(cfg *NetworkConfig) { cfg.URL = "" }},
+		{name: "zero timeout", modify: func(cfg *NetworkConfig) { cfg.Timeout = 0 }},
+		{name: "negative timeout", modify: func(cfg *NetworkConfig) { cfg.Timeout = -1 }},
+		{name: "faucet url without scheme", modify: func(cfg *NetworkConfig) { cfg.Faucet.URL = "faucet.altnet.rippletest.net/accounts" }},
+		{name: "mismatched account", modify: func(cfg *NetworkConfig) { cfg.System.Account = "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC" }},
+		{name: "mismatched secret", modify: func(cfg *NetworkConfig) {
+			cfg.System.Secret = "ED0BF5F1F124C884B1A5AE4A48C816FCF554FC3A0D9A07C0F7EB1CA91F7B94814C"
+		}},
+		{name: "malformed secret", modify: func(cfg *NetworkConfig) { cfg.System.Secret = "secret" }},
+		{name: "malformed public key", modify: func(cfg *NetworkConfig) { cfg.System.Public = "public" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validNetworkConfig()
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			assert.True(t, errors.Is(err, ErrInvalidNetworkConfig), "got %v", err)
+		})
+	}
+}
+
+func TestNetworkConfig_Validate_DoesNotLeakSecret(t *testing.T) {
+	cfg := validNetworkConfig()
+	cfg.System.Account = "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC"
+
+	err := cfg.Validate()
+	if assert.Error(t, err) {
+		assert.NotContains(t, err.Error(), cfg.System.Secret)
+	}
+}
+
+func TestConfig_RedactedConfigLog(t *testing.T) {
+	const pass = "0123456789abcdef-3"
+	cfg := Config{Network: validNetworkConfig()}
+	cfg.Features.CreditorSigners = []CreditorSigners{{Address: "rKxt8PgUy4ggMY53GXuqU6i2aJ2HymW2YC", Passes: []string{pass}}}
+
+	log := cfg.RedactedConfigLog()
+	assert.NotContains(t, log, cfg.Network.System.Secret)
+	assert.NotContains(t, log, pass)
+
+	// Only the logged copy is redacted
+	assert.Equal(t, []string{pass}, cfg.Features.CreditorSigners[0].Passes)
+}
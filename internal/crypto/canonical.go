@@ -0,0 +1,55 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	xrplcrypto "github.com/Peersyst/xrpl-go/pkg/crypto"
+)
+
+var (
+	// ErrNonCanonicalSignature is returned when a secp256k1 signature is not fully canonical.
+	ErrNonCanonicalSignature = errors.New("non-canonical signature")
+
+	// secp256k1Order is the order N of the secp256k1 curve.
+	secp256k1Order, _ = new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141", 16)
+	// secp256k1HalfOrder is N/2, the largest S of a fully canonical signature.
+	secp256k1HalfOrder = new(big.Int).Rsh(secp256k1Order, 1)
+)
+
+// CheckCanonicalSignature checks a transaction signature is fully canonical, as the network
+// requires since the RequireFullyCanonicalSig amendment made the tfFullyCanonicalSig flag
+// the only accepted form: a secp256k1 signature must be DER encoded with 0 < R < N and
+// 0 < S <= N/2. Any signature (R, S) has the equally valid twin (R, N-S), so accepting
+// both would make transactions malleable.
+//
+// Ed25519 signatures have a single valid encoding and are not checked.
+//
+// The XRPL keypairs sign secp256k1 through decred's ecdsa.Sign, which produces RFC 6979
+// signatures normalized to low S, so the keypairs already guarantee canonical signatures.
+// The check guards that guarantee against a change of the signing library.
+//
+// Parameters:
+// - signature: The hex encoded signature
+// - pubKey: The hex encoded public key of the signer
+//
+// Returns nil if the signature is canonical, or an error wrapping ErrNonCanonicalSignature.
+func CheckCanonicalSignature(signature, pubKey string) error {
+	if len(pubKey) >= 2 && (pubKey[:2] == "ED" || pubKey[:2] == "ed") {
+		return nil
+	}
+
+	r, s, err := xrplcrypto.DERHexToSig(signature)
+	if err != nil {
+		return fmt.Errorf("%w: malformed DER signature: %v", ErrNonCanonicalSignature, err)
+	}
+	rInt, sInt := new(big.Int).SetBytes(r), new(big.Int).SetBytes(s)
+	if rInt.Sign() <= 0 || rInt.Cmp(secp256k1Order) >= 0 {
+		return fmt.Errorf("%w: R out of range", ErrNonCanonicalSignature)
+	}
+	if sInt.Sign() <= 0 || sInt.Cmp(secp256k1HalfOrder) > 0 {
+		return fmt.Errorf("%w: S is not in the lower half of the curve order", ErrNonCanonicalSignature)
+	}
+	return nil
+}
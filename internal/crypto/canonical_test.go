@@ -0,0 +1,57 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	xrplcrypto "github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+// secpFamilySeed derives a secp256k1 keypair; wallets derived from hex seeds sign with Ed25519.
+const secpFamilySeed = "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"
+
+// TestKeypairsSign_Canonical asserts the signing library keeps producing low-S signatures.
+func TestKeypairsSign_Canonical(t *testing.T) {
+	w, err := NewWalletFromSeed(secpFamilySeed, KeyAlgorithmSecp256k1)
+	assert.NoError(t, err)
+
+	for i := 0; i < 64; i++ {
+		signature, err := keypairs.Sign(fmt.Sprintf("message %d", i), w.PrivateKey)
+		assert.NoError(t, err)
+		assert.NoError(t, CheckCanonicalSignature(signature, w.PublicKey), "message %d", i)
+	}
+}
+
+func TestCheckCanonicalSignature_HighS(t *testing.T) {
+	w, err := NewWalletFromSeed(secpFamilySeed, KeyAlgorithmSecp256k1)
+	assert.NoError(t, err)
+	signature, err := keypairs.Sign("message", w.PrivateKey)
+	assert.NoError(t, err)
+
+	// (R, N-S) verifies like (R, S) but is the high-S twin
+	r, s, err := xrplcrypto.DERHexToSig(signature)
+	assert.NoError(t, err)
+	highS := new(big.Int).Sub(secp256k1Order, new(big.Int).SetBytes(s))
+	twin, err := xrplcrypto.DERHexFromSig(new(big.Int).SetBytes(r).Text(16), highS.Text(16))
+	assert.NoError(t, err)
+	assert.True(t, xrplcrypto.SECP256K1().Validate("message", w.PublicKey, twin))
+
+	err = CheckCanonicalSignature(twin, w.PublicKey)
+	assert.True(t, errors.Is(err, ErrNonCanonicalSignature), "got %v", err)
+
+	err = CheckCanonicalSignature("3006020100020100", w.PublicKey)
+	assert.True(t, errors.Is(err, ErrNonCanonicalSignature), "got %v", err)
+}
+
+func TestCheckCanonicalSignature_Ed25519(t *testing.T) {
+	private, public, err := keypairs.DeriveKeypair("sEdTM1uX8pu2do5XvTnutH6HsouMaM2", false)
+	assert.NoError(t, err)
+	signature, err := keypairs.Sign("message", private)
+	assert.NoError(t, err)
+
+	assert.NoError(t, CheckCanonicalSignature(signature, public))
+}
@@ -5,7 +5,9 @@ package crypto
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -17,6 +19,29 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 )
 
+var (
+	// ErrInvalidSeedLength is returned when a seed is outside the 16 to 64 bytes BIP-32 accepts.
+	ErrInvalidSeedLength = errors.New("invalid seed length")
+)
+
+// accountPathPrefix is m/44'/144'/0'/0, the standard XRPL derivation path without the account index.
+var accountPathPrefix = []uint32{
+	hdkeychain.HardenedKeyStart + 44,
+	hdkeychain.HardenedKeyStart + 144,
+	hdkeychain.HardenedKeyStart + 0,
+	0,
+}
+
+// DerivationPath returns the standard XRPL derivation path of an account index.
+//
+// Parameters:
+// - account: The account index, the last component of the path
+//
+// Returns the path m/44'/144'/0'/0/<account>.
+func DerivationPath(account uint32) string {
+	return fmt.Sprintf("m/44'/144'/0'/0/%d", account)
+}
+
 // GetExtendedKeyFromHexSeedWithPath creates an extended key from a hexadecimal seed string
 // and derives it along the specified BIP-44 derivation path.
 //
@@ -47,17 +72,28 @@ func GetExtendedKeyFromHexSeedWithPath(hexSeed string, path string) (*hdkeychain
 // - seed: Raw bytes representing the master seed
 // - path: The BIP-44 derivation path (e.g., "m/44'/144'/0'/0/0")
 //
-// Returns an extended key derived along the specified path, or an error if derivation fails.
+// Returns an extended key derived along the specified path, an error wrapping ErrInvalidSeedLength
+// if the seed is not 16 to 64 bytes long, or an error if derivation fails.
 // The function uses MainNet parameters for key derivation.
 func GetExtendedKeyFromSeedWithPath(seed []byte, path string) (*hdkeychain.ExtendedKey, error) {
-	masterKey, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	derivationPath, err := parseDerivationPath(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create master key: %w", err)
+		return nil, fmt.Errorf("failed to parse derivation path: %w", err)
 	}
 
-	derivationPath, err := parseDerivationPath(path)
+	return deriveKey(seed, derivationPath)
+}
+
+// deriveKey creates the master key of the seed and derives it along the path indices.
+func deriveKey(seed []byte, derivationPath []uint32) (*hdkeychain.ExtendedKey, error) {
+	if len(seed) < hdkeychain.MinSeedBytes || len(seed) > hdkeychain.MaxSeedBytes {
+		return nil, fmt.Errorf("%w: %d bytes, expected %d to %d", ErrInvalidSeedLength,
+			len(seed), hdkeychain.MinSeedBytes, hdkeychain.MaxSeedBytes)
+	}
+
+	masterKey, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse derivation path: %w", err)
+		return nil, fmt.Errorf("failed to create master key: %w", err)
 	}
 
 	currentKey := masterKey
@@ -244,3 +280,25 @@ func NewWalletFromHexSeed(hexSeed string, path string) (*wallet.Wallet, error) {
 	}
 	return NewWalletFromExtendedKey(key)
 }
+
+// NewWalletFromHexSeedIndex creates a new Wallet from a hexadecimal seed and the account index
+// of the standard XRPL derivation path m/44'/144'/0'/0/<account>. It derives the same wallet
+// as NewWalletFromHexSeed with DerivationPath(account), without formatting and parsing a path.
+//
+// Parameters:
+// - hexSeed: A hexadecimal string representing the master seed
+// - account: The account index, the last component of the path
+//
+// Returns a new Wallet instance or an error if creation fails.
+func NewWalletFromHexSeedIndex(hexSeed string, account uint32) (*wallet.Wallet, error) {
+	seed, err := hex.DecodeString(hexSeed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode hex seed: %w", err)
+	}
+
+	key, err := deriveKey(seed, append(slices.Clone(accountPathPrefix), account))
+	if err != nil {
+		return nil, err
+	}
+	return NewWalletFromExtendedKey(key)
+}
@@ -0,0 +1,67 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// messagePrefix starts every signed message. Signed transactions start with a four byte
+// hash prefix such as "STX\x00", so a signed message can never be a valid transaction.
+const messagePrefix = "\x19XRPL Signed Message:\n"
+
+// ErrEmptyMessage is returned when signing an empty message.
+var ErrEmptyMessage = errors.New("empty message")
+
+// prefixedMessage returns the bytes actually signed for a message: messagePrefix, the
+// length of the message in decimal and the message itself.
+func prefixedMessage(message []byte) string {
+	return messagePrefix + strconv.Itoa(len(message)) + string(message)
+}
+
+// SignMessage signs an arbitrary message, such as an off-chain attestation, with the
+// wallet's key. The message is prefixed before signing, so the signature cannot be
+// replayed as the signature of a transaction.
+//
+// Parameters:
+// - w: The wallet whose key signs the message
+// - message: The message to sign
+//
+// Returns the hex encoded signature, or an error if the wallet or message is empty
+// or the key cannot sign.
+func SignMessage(w *wallet.Wallet, message []byte) (signature string, err error) {
+	if w == nil {
+		return "", fmt.Errorf("wallet cannot be nil")
+	}
+	if len(message) == 0 {
+		return "", ErrEmptyMessage
+	}
+
+	signature, err = keypairs.Sign(prefixedMessage(message), w.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign message: %w", err)
+	}
+	return signature, nil
+}
+
+// VerifyMessage checks a signature produced by SignMessage.
+//
+// Parameters:
+// - pubKey: The hex encoded public key of the signer
+// - message: The signed message
+// - signature: The hex encoded signature
+//
+// Returns whether the signature is valid for the message and key, or an error wrapping
+// ErrInvalidPublicKey if the key is malformed.
+func VerifyMessage(pubKey string, message []byte, signature string) (bool, error) {
+	if err := checkPublicKey(pubKey); err != nil {
+		return false, err
+	}
+	if len(message) == 0 || signature == "" {
+		return false, nil
+	}
+	return validSignature(prefixedMessage(message), signature, pubKey), nil
+}
@@ -0,0 +1,55 @@
+package crypto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignMessage(t *testing.T) {
+	message := []byte(`{"attestation":"loan 42 is funded"}`)
+
+	secp, err := NewWalletFromHexSeed(hexSeed, derivationPath)
+	assert.NoError(t, err)
+	ed, err := wallet.FromSeed("sEdTM1uX8pu2do5XvTnutH6HsouMaM2", "")
+	assert.NoError(t, err)
+
+	for name, w := range map[string]*wallet.Wallet{"secp256k1": secp, "ed25519": &ed} {
+		t.Run(name, func(t *testing.T) {
+			signature, err := SignMessage(w, message)
+			assert.NoError(t, err)
+
+			ok, err := VerifyMessage(w.PublicKey, message, signature)
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			tampered := append([]byte{}, message...)
+			tampered[len(tampered)-2] ^= 1
+			ok, err = VerifyMessage(w.PublicKey, tampered, signature)
+			assert.NoError(t, err)
+			assert.False(t, ok, "a tampered message is rejected")
+
+			ok, err = VerifyMessage(w.PublicKey, message, "")
+			assert.NoError(t, err)
+			assert.False(t, ok)
+
+			// The message is prefixed: a raw signature of it is not a message signature
+			raw, err := keypairs.Sign(string(message), w.PrivateKey)
+			assert.NoError(t, err)
+			ok, err = VerifyMessage(w.PublicKey, message, raw)
+			assert.NoError(t, err)
+			assert.False(t, ok)
+		})
+	}
+
+	_, err = SignMessage(secp, nil)
+	assert.True(t, errors.Is(err, ErrEmptyMessage))
+	_, err = SignMessage(nil, message)
+	assert.Error(t, err)
+
+	_, err = VerifyMessage("05"+secp.PublicKey[2:], message, "3006020101020101")
+	assert.True(t, errors.Is(err, ErrInvalidPublicKey), "got %v", err)
+}
@@ -0,0 +1,97 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	xrplcrypto "github.com/Peersyst/xrpl-go/pkg/crypto"
+)
+
+const (
+	// publicKeyLength is the length of an XRPL public key in hex characters,
+	// a one byte key type prefix followed by 32 bytes.
+	publicKeyLength = 66
+	// scalarLength is the largest size, in bytes, of the r and s values of a secp256k1 signature.
+	scalarLength = 32
+)
+
+var (
+	// ErrInvalidSignature is returned when a request signature does not match the canonical message.
+	ErrInvalidSignature = errors.New("invalid request signature")
+	// ErrInvalidPublicKey is returned when a public key is not a hex encoded secp256k1 or Ed25519 key.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+)
+
+// RequestMessage returns the canonical message signed to authorize an operation on a document:
+// the operation name and the document hash joined by a colon, such as "Transfer:<docHash>".
+// Binding the operation keeps a signature for one operation from authorizing another.
+//
+// Parameters:
+// - operation: The name of the authorized operation, such as "Emission" or "Transfer"
+// - docHash: The hash of the document the operation applies to
+//
+// Returns the message to sign.
+func RequestMessage(operation, docHash string) string {
+	return operation + ":" + docHash
+}
+
+// VerifyRequestSignature checks a request signature over RequestMessage(operation, docHash).
+// The signature is hex encoded, as produced by signing the message with an XRPL keypair:
+// DER encoded for secp256k1 keys (prefix 02 or 03) and raw for Ed25519 keys (prefix ED).
+//
+// Parameters:
+// - operation: The name of the authorized operation
+// - docHash: The hash of the document the operation applies to
+// - signature: The hex encoded signature
+// - pubKey: The hex encoded public key of the signer
+//
+// Returns nil if the signature is valid, an error wrapping ErrInvalidPublicKey if the key
+// is malformed, or an error wrapping ErrInvalidSignature otherwise.
+func VerifyRequestSignature(operation, docHash, signature, pubKey string) error {
+	if err := checkPublicKey(pubKey); err != nil {
+		return err
+	}
+	if signature == "" {
+		return fmt.Errorf("%w: signature is empty", ErrInvalidSignature)
+	}
+	if docHash == "" {
+		return fmt.Errorf("%w: document hash is empty", ErrInvalidSignature)
+	}
+
+	if !validSignature(RequestMessage(operation, docHash), signature, pubKey) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// checkPublicKey checks a public key is hex encoded and of the XRPL public key length.
+func checkPublicKey(pubKey string) error {
+	if len(pubKey) != publicKeyLength {
+		return fmt.Errorf("%w: %d hex characters, expected %d", ErrInvalidPublicKey, len(pubKey), publicKeyLength)
+	}
+	if _, err := hex.DecodeString(pubKey); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	switch strings.ToUpper(pubKey[:2]) {
+	case "ED", "02", "03":
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown key type %s", ErrInvalidPublicKey, pubKey[:2])
+	}
+}
+
+// validSignature reports whether the signature of the message is valid for a public key
+// accepted by checkPublicKey.
+func validSignature(message, signature, pubKey string) bool {
+	if strings.EqualFold(pubKey[:2], "ED") {
+		return xrplcrypto.ED25519().Validate(message, pubKey, signature)
+	}
+	// The client does not bound the DER values before copying them into 32 byte arrays
+	r, sv, err := xrplcrypto.DERHexToSig(signature)
+	if err != nil || len(r) > scalarLength || len(sv) > scalarLength {
+		return false
+	}
+	return xrplcrypto.SECP256K1().Validate(message, pubKey, signature)
+}
@@ -0,0 +1,59 @@
+package crypto
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/keypairs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyRequestSignature(t *testing.T) {
+	const docHash = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	secp, err := NewWalletFromHexSeed(hexSeed, derivationPath)
+	assert.NoError(t, err)
+	edPrivate, edPublic, err := keypairs.DeriveKeypair("sEdTM1uX8pu2do5XvTnutH6HsouMaM2", false)
+	assert.NoError(t, err)
+
+	keys := []struct {
+		name    string
+		private string
+		public  string
+	}{
+		{name: "secp256k1", private: secp.PrivateKey, public: secp.PublicKey},
+		{name: "ed25519", private: edPrivate, public: edPublic},
+	}
+	for _, key := range keys {
+		t.Run(key.name, func(t *testing.T) {
+			signature, err := keypairs.Sign(RequestMessage("Transfer", docHash), key.private)
+			assert.NoError(t, err)
+
+			assert.NoError(t, VerifyRequestSignature("Transfer", docHash, signature, key.public))
+
+			// A signature for another operation or document is forged for this one
+			err = VerifyRequestSignature("Emission", docHash, signature, key.public)
+			assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+			err = VerifyRequestSignature("Transfer", docHash[1:]+"0", signature, key.public)
+			assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+			forged := []byte(signature)
+			forged[len(forged)-3] ^= 1
+			err = VerifyRequestSignature("Transfer", docHash, string(forged), key.public)
+			assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+			err = VerifyRequestSignature("Transfer", docHash, "", key.public)
+			assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+		})
+	}
+
+	// Oversized DER values are rejected instead of verified
+	err = VerifyRequestSignature("Transfer", docHash, "3046"+"0221"+strings.Repeat("11", 33)+"0221"+strings.Repeat("22", 33), secp.PublicKey)
+	assert.True(t, errors.Is(err, ErrInvalidSignature), "got %v", err)
+
+	for _, pubKey := range []string{"", "02", "ZZ" + secp.PublicKey[2:], "05" + secp.PublicKey[2:]} {
+		err = VerifyRequestSignature("Transfer", docHash, "3006020101020101", pubKey)
+		assert.True(t, errors.Is(err, ErrInvalidPublicKey), "public key %q: got %v", pubKey, err)
+	}
+}
@@ -0,0 +1,97 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	ac "github.com/Peersyst/xrpl-go/address-codec"
+	"github.com/Peersyst/xrpl-go/address-codec/interfaces"
+	"github.com/Peersyst/xrpl-go/keypairs"
+	xrplcrypto "github.com/Peersyst/xrpl-go/pkg/crypto"
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// KeyAlgorithm selects the signing algorithm of the keypair derived from a seed.
+type KeyAlgorithm int
+
+const (
+	// KeyAlgorithmDefault uses the algorithm encoded in a family seed,
+	// and secp256k1 for a hex seed.
+	KeyAlgorithmDefault KeyAlgorithm = iota
+	// KeyAlgorithmSecp256k1 derives a secp256k1 keypair.
+	KeyAlgorithmSecp256k1
+	// KeyAlgorithmEd25519 derives an Ed25519 keypair. Hex seeds do not support it.
+	KeyAlgorithmEd25519
+)
+
+var (
+	// ErrUnrecognizedSeed is returned when a seed is neither a base58 family seed nor a hex seed.
+	ErrUnrecognizedSeed = errors.New("unrecognized seed format")
+	// ErrUnsupportedKeyAlgorithm is returned when a seed cannot derive a keypair of the requested algorithm.
+	ErrUnsupportedKeyAlgorithm = errors.New("unsupported key algorithm")
+)
+
+// NewWalletFromSeed creates a new Wallet from either an XRPL family seed or a hex seed.
+// A base58 family seed (starting with "s") derives its keypair directly, the way rippled
+// and the XRPL libraries do; a hex seed is derived along the BIP-44 path of account 0,
+// like NewWalletFromHexSeedIndex(seed, 0).
+//
+// Parameters:
+// - seed: A base58 family seed such as "snoPBrXtMeMyMHUVTgbuqAfg1SUTb", or a hexadecimal master seed
+// - algo: The keypair algorithm; KeyAlgorithmDefault follows the seed, an explicit algorithm
+// overrides the one encoded in a family seed
+//
+// Returns a new Wallet instance, an error wrapping ErrUnrecognizedSeed if the seed format is
+// not recognized, or an error wrapping ErrUnsupportedKeyAlgorithm if a hex seed is asked for Ed25519.
+func NewWalletFromSeed(seed string, algo KeyAlgorithm) (*wallet.Wallet, error) {
+	if strings.HasPrefix(seed, "s") {
+		return newWalletFromFamilySeed(seed, algo)
+	}
+
+	if _, err := hex.DecodeString(seed); err != nil || seed == "" {
+		return nil, fmt.Errorf("%w: expected a base58 family seed or a hex seed", ErrUnrecognizedSeed)
+	}
+	if algo != KeyAlgorithmDefault && algo != KeyAlgorithmSecp256k1 {
+		return nil, fmt.Errorf("%w: BIP-44 derivation of hex seeds is secp256k1 only", ErrUnsupportedKeyAlgorithm)
+	}
+	return NewWalletFromHexSeedIndex(seed, 0)
+}
+
+// newWalletFromFamilySeed derives the keypair of a base58 family seed.
+func newWalletFromFamilySeed(seed string, algo KeyAlgorithm) (*wallet.Wallet, error) {
+	entropy, encoded, err := ac.DecodeSeed(seed)
+	if err != nil || len(entropy) != ac.FamilySeedLength {
+		return nil, fmt.Errorf("%w: invalid family seed", ErrUnrecognizedSeed)
+	}
+
+	var alg interfaces.CryptoImplementation
+	switch algo {
+	case KeyAlgorithmDefault:
+		alg = encoded
+	case KeyAlgorithmSecp256k1:
+		alg = xrplcrypto.SECP256K1()
+	case KeyAlgorithmEd25519:
+		alg = xrplcrypto.ED25519()
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedKeyAlgorithm, algo)
+	}
+
+	private, public, err := alg.DeriveKeypair(entropy, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive keypair: %w", err)
+	}
+	address, err := keypairs.DeriveClassicAddress(public)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive address: %w", err)
+	}
+
+	return &wallet.Wallet{
+		ClassicAddress: types.Address(address),
+		PublicKey:      public,
+		PrivateKey:     private,
+		Seed:           seed,
+	}, nil
+}
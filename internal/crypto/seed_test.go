@@ -0,0 +1,52 @@
+package crypto
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Peersyst/xrpl-go/xrpl/transaction/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWalletFromSeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    string
+		algo    KeyAlgorithm
+		address string
+	}{
+		// The genesis account, derived from the "masterpassphrase" seed
+		{name: "secp256k1 family seed", seed: "snoPBrXtMeMyMHUVTgbuqAfg1SUTb", address: "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"},
+		{name: "ed25519 family seed", seed: "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r", address: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"},
+		{name: "explicit algorithm", seed: "sEdSKaCy2JT7JaM7v95H9SxkhP9wS2r", algo: KeyAlgorithmEd25519, address: "rLUEXYuLiQptky37CqLcm9USQpPiz5rkpD"},
+		{name: "hex seed", seed: hexSeed, address: address},
+		{name: "hex seed secp256k1", seed: hexSeed, algo: KeyAlgorithmSecp256k1, address: address},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := NewWalletFromSeed(tt.seed, tt.algo)
+			if assert.NoError(t, err) {
+				assert.Equal(t, types.Address(tt.address), w.ClassicAddress)
+				assert.NotEmpty(t, w.PublicKey)
+				assert.NotEmpty(t, w.PrivateKey)
+			}
+		})
+	}
+
+	// An explicit algorithm overrides the one encoded in the family seed
+	w, err := NewWalletFromSeed("snoPBrXtMeMyMHUVTgbuqAfg1SUTb", KeyAlgorithmEd25519)
+	if assert.NoError(t, err) {
+		assert.NotEqual(t, types.Address("rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"), w.ClassicAddress)
+		assert.Equal(t, "ED", w.PublicKey[:2])
+	}
+}
+
+func TestNewWalletFromSeed_Invalid(t *testing.T) {
+	for _, seed := range []string{"", "not a seed", "snoPBrXtMeMyMHUVTgbuqAfg1SUTc", "rHb9CJAWyB4rj91VRWn96DkukG4bwdtyTh"} {
+		_, err := NewWalletFromSeed(seed, KeyAlgorithmDefault)
+		assert.True(t, errors.Is(err, ErrUnrecognizedSeed), "seed %q: got %v", seed, err)
+	}
+
+	_, err := NewWalletFromSeed(hexSeed, KeyAlgorithmEd25519)
+	assert.True(t, errors.Is(err, ErrUnsupportedKeyAlgorithm), "got %v", err)
+}
@@ -0,0 +1,149 @@
+package crypto
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/Peersyst/xrpl-go/xrpl/wallet"
+)
+
+// DefaultWalletCacheSize is the number of derived wallets kept by a wallet cache
+// when no size is configured.
+const DefaultWalletCacheSize = 1024
+
+// walletCacheKey identifies a derived wallet. The seed is kept only as its SHA-256
+// digest so the cache index never holds key material in plaintext.
+type walletCacheKey struct {
+	seed [sha256.Size]byte
+	path string
+}
+
+type walletCacheEntry struct {
+	key    walletCacheKey
+	wallet *wallet.Wallet
+}
+
+// WalletCache is a bounded least-recently-used cache of wallets derived by
+// NewWalletFromHexSeed, keyed by seed and derivation path.
+//
+// BIP-44 derivation is deterministic, so a cached wallet is identical to a freshly
+// derived one. Returned wallets are shared between callers and must not be modified.
+// A WalletCache is safe for concurrent use; a nil WalletCache derives every wallet.
+type WalletCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[walletCacheKey]*list.Element
+}
+
+// NewWalletCache creates a wallet cache holding up to size wallets.
+//
+// Parameters:
+// - size: The maximum number of cached wallets, zero or negative uses DefaultWalletCacheSize
+//
+// Returns an empty WalletCache.
+func NewWalletCache(size int) *WalletCache {
+	if size <= 0 {
+		size = DefaultWalletCacheSize
+	}
+	return &WalletCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[walletCacheKey]*list.Element, size),
+	}
+}
+
+// WalletFromHexSeed returns the wallet of the seed and derivation path, deriving it
+// with NewWalletFromHexSeed on a cache miss. Failed derivations are not cached.
+//
+// Parameters:
+// - hexSeed: A hexadecimal string representing the master seed
+// - path: The BIP-44 derivation path (e.g., "m/44'/144'/0'/0/0")
+//
+// Returns the wallet or an error if derivation fails.
+func (c *WalletCache) WalletFromHexSeed(hexSeed string, path string) (*wallet.Wallet, error) {
+	return c.lookup(hexSeed, path, func() (*wallet.Wallet, error) {
+		return NewWalletFromHexSeed(hexSeed, path)
+	})
+}
+
+// WalletFromHexSeedIndex returns the wallet of the seed and account index, deriving it
+// with NewWalletFromHexSeedIndex on a cache miss. It shares cache entries with
+// WalletFromHexSeed for the path DerivationPath(account).
+//
+// Parameters:
+// - hexSeed: A hexadecimal string representing the master seed
+// - account: The account index, the last component of the path
+//
+// Returns the wallet or an error if derivation fails.
+func (c *WalletCache) WalletFromHexSeedIndex(hexSeed string, account uint32) (*wallet.Wallet, error) {
+	derive := func() (*wallet.Wallet, error) {
+		return NewWalletFromHexSeedIndex(hexSeed, account)
+	}
+	if c == nil {
+		return derive()
+	}
+	return c.lookup(hexSeed, DerivationPath(account), derive)
+}
+
+// lookup returns the cached wallet of the seed and path, or caches the wallet returned by derive.
+func (c *WalletCache) lookup(hexSeed, path string, derive func() (*wallet.Wallet, error)) (*wallet.Wallet, error) {
+	if c == nil {
+		return derive()
+	}
+
+	key := walletCacheKey{seed: sha256.Sum256([]byte(hexSeed)), path: path}
+	if w, ok := c.get(key); ok {
+		return w, nil
+	}
+
+	// Derivation runs without the lock, so concurrent misses of the same key may both
+	// derive; the first wallet added is kept and returned to both.
+	w, err := derive()
+	if err != nil {
+		return nil, err
+	}
+	return c.add(key, w), nil
+}
+
+// Len returns the number of cached wallets.
+func (c *WalletCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *WalletCache) get(key walletCacheKey) (*wallet.Wallet, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*walletCacheEntry).wallet, true
+}
+
+// add caches the wallet unless the key is already cached, and returns the cached wallet.
+func (c *WalletCache) add(key walletCacheKey, w *wallet.Wallet) *wallet.Wallet {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.order.MoveToFront(elem)
+		return elem.Value.(*walletCacheEntry).wallet
+	}
+
+	c.entries[key] = c.order.PushFront(&walletCacheEntry{key: key, wallet: w})
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*walletCacheEntry).key)
+	}
+	return w
+}
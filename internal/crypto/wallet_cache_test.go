@@ -0,0 +1,119 @@
+package crypto
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalletCache_ReturnsIdenticalWallet(t *testing.T) {
+	cache := NewWalletCache(4)
+
+	derived, err := NewWalletFromHexSeed(hexSeed, derivationPath)
+	assert.NoError(t, err)
+
+	first, err := cache.WalletFromHexSeed(hexSeed, derivationPath)
+	assert.NoError(t, err)
+	assert.Equal(t, derived, first)
+	assert.Equal(t, address, first.ClassicAddress.String())
+
+	second, err := cache.WalletFromHexSeed(hexSeed, derivationPath)
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, cache.Len())
+
+	// Another path of the same seed is another wallet
+	other, err := cache.WalletFromHexSeed(hexSeed, "m/44'/144'/0'/0/1")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.ClassicAddress, other.ClassicAddress)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestWalletCache_IndexSharesPathEntry(t *testing.T) {
+	cache := NewWalletCache(4)
+
+	byPath, err := cache.WalletFromHexSeed(hexSeed, DerivationPath(3))
+	assert.NoError(t, err)
+
+	byIndex, err := cache.WalletFromHexSeedIndex(hexSeed, 3)
+	assert.NoError(t, err)
+	assert.Same(t, byPath, byIndex)
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestWalletCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewWalletCache(2)
+
+	path := func(i int) string { return fmt.Sprintf("m/44'/144'/0'/0/%d", i) }
+
+	w0, err := cache.WalletFromHexSeed(hexSeed, path(0))
+	assert.NoError(t, err)
+	_, err = cache.WalletFromHexSeed(hexSeed, path(1))
+	assert.NoError(t, err)
+
+	// Using path 0 makes path 1 the least recently used
+	again, err := cache.WalletFromHexSeed(hexSeed, path(0))
+	assert.NoError(t, err)
+	assert.Same(t, w0, again)
+
+	_, err = cache.WalletFromHexSeed(hexSeed, path(2))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cache.Len())
+
+	again, err = cache.WalletFromHexSeed(hexSeed, path(0))
+	assert.NoError(t, err)
+	assert.Same(t, w0, again)
+}
+
+func TestWalletCache_InvalidSeedNotCached(t *testing.T) {
+	cache := NewWalletCache(1)
+
+	_, err := cache.WalletFromHexSeed("invalid_hex", derivationPath)
+	assert.Error(t, err)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestWalletCache_Nil(t *testing.T) {
+	var cache *WalletCache
+
+	w, err := cache.WalletFromHexSeed(hexSeed, derivationPath)
+	assert.NoError(t, err)
+	assert.Equal(t, address, w.ClassicAddress.String())
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestWalletCache_Concurrent(t *testing.T) {
+	cache := NewWalletCache(2)
+
+	var wg sync.WaitGroup
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w, err := cache.WalletFromHexSeed(hexSeed, fmt.Sprintf("m/44'/144'/0'/0/%d", i%3))
+			assert.NoError(t, err)
+			assert.NotNil(t, w)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 2, cache.Len())
+}
+
+func BenchmarkNewWalletFromHexSeed(b *testing.B) {
+	for b.Loop() {
+		if _, err := NewWalletFromHexSeed(hexSeed, derivationPath); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkWalletCache_WalletFromHexSeed(b *testing.B) {
+	cache := NewWalletCache(DefaultWalletCacheSize)
+	for b.Loop() {
+		if _, err := cache.WalletFromHexSeed(hexSeed, derivationPath); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -39,16 +39,31 @@ func ProvideLogger(cfg config.LogConfig) *slog.Logger {
 // This provider creates the main blockchain interface that handles all XRPL network interactions.
 // It's marked as "OrPanic" because the application cannot function without blockchain connectivity.
 //
+// When an audit log is configured, every submitted transaction is recorded to it.
+//
 // Parameters:
 // - cfg: Network configuration including RPC URL, timeout, and system account details
+// - l: A configured logger instance used to report audit failures and log transactions
 //
 // Returns a configured Blockchain instance or panics if creation fails.
-func ProvideBlockchainOrPanic(cfg config.NetworkConfig) *api.Blockchain {
+func ProvideBlockchainOrPanic(cfg config.NetworkConfig, l *slog.Logger) *api.Blockchain {
 	bc, err := api.NewBlockchain(cfg)
 	if err != nil {
 		slog.Error("failed to create blockchain", "error", err)
 		panic(err)
 	}
+
+	if cfg.AuditLog != "" {
+		auditor, err := api.NewFileAuditor(cfg.AuditLog)
+		if err != nil {
+			slog.Error("failed to open audit log", "path", cfg.AuditLog, "error", err)
+			panic(err)
+		}
+		bc.SetAuditor(auditor, l)
+	}
+	if cfg.LogTransactions {
+		bc.EnableTransactionLog(l)
+	}
 	return bc
 }
 
@@ -72,10 +87,13 @@ func ProvideAccountAPI(l *slog.Logger, bc *api.Blockchain) accountv1.AccountAPIS
 // Parameters:
 // - l: A configured logger instance
 // - bc: The blockchain interface for XRPL network operations
+// - metadata: The defaults of the metadata of emitted warrants
 //
 // Returns a TokenAPIServer implementation.
-func ProvideTokenAPI(l *slog.Logger, bc *api.Blockchain, features *config.FeatureConfig) tokenv1.TokenAPIServer {
-	return api.NewToken(l, bc, features)
+func ProvideTokenAPI(l *slog.Logger, bc *api.Blockchain, features *config.FeatureConfig, metadata config.MetadataDefaults) tokenv1.TokenAPIServer {
+	t := api.NewToken(l, bc, features)
+	t.SetMetadataDefaults(metadata)
+	return t
 }
 
 // ProvideAppServer returns a new application Server using the provided logger and gRPC server.
@@ -103,9 +121,10 @@ func ProvideAppServer(l *slog.Logger, accountAPI accountv1.AccountAPIServer, tok
 // Parameters:
 // - cfg: Logging configuration for the application
 // - netCfg: Network configuration for XRPL connectivity
+// - metadata: Defaults of the warrant token metadata
 //
 // Returns a fully configured and wired application server.
-func InitializeServer(cfg config.LogConfig, netCfg config.NetworkConfig, features *config.FeatureConfig) *server.Server {
+func InitializeServer(cfg config.LogConfig, netCfg config.NetworkConfig, features *config.FeatureConfig, metadata config.MetadataDefaults) *server.Server {
 	wire.Build(
 		ProvideLogger,
 		ProvideBlockchainOrPanic,
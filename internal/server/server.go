@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"gitlab.com/warrant1/warrant/chain-xrpl/internal/api"
 	accountv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/account/v1"
 	tokenv1 "gitlab.com/warrant1/warrant/protobuf/blockchain/token/v1"
 	"golang.org/x/sync/errgroup"
@@ -42,7 +43,7 @@ type Server struct {
 // The gRPC server will need to have services registered before use.
 func NewServer(logger *slog.Logger) *Server {
 	return &Server{
-		grpcServer: grpc.NewServer(),
+		grpcServer: newGRPCServer(),
 		logger:     logger,
 	}
 }
@@ -76,7 +77,7 @@ func NewServerWithGRPC(logger *slog.Logger, grpcServer *grpc.Server) *Server {
 //
 // Returns a new Server instance with the APIs registered on an internal gRPC server.
 func NewServerWithAPIs(logger *slog.Logger, accountAPI accountv1.AccountAPIServer, tokenAPI tokenv1.TokenAPIServer) *Server {
-	grpcServer := grpc.NewServer()
+	grpcServer := newGRPCServer()
 	accountv1.RegisterAccountAPIServer(grpcServer, accountAPI)
 	tokenv1.RegisterTokenAPIServer(grpcServer, tokenAPI)
 
@@ -86,6 +87,15 @@ func NewServerWithAPIs(logger *slog.Logger, accountAPI accountv1.AccountAPIServe
 	}
 }
 
+// newGRPCServer creates a gRPC server binding the correlation ID of every request
+// to its context, so that handlers log it and record it with submitted transactions.
+func newGRPCServer() *grpc.Server {
+	return grpc.NewServer(
+		grpc.ChainUnaryInterceptor(api.UnaryCorrelationInterceptor()),
+		grpc.ChainStreamInterceptor(api.StreamCorrelationInterceptor()),
+	)
+}
+
 // Run starts the gRPC server on the specified address.
 // This is a simple blocking call that starts the server and waits for it to stop.
 //